@@ -34,6 +34,35 @@ type SecretAzure struct {
 	ExpiryTimeStamp *metav1.Time `json:"expiryTimeStamp"`
 }
 
+type SecretGCS struct {
+	// ProjectID is the ID of the project that the bucket belongs to
+	ProjectID string `json:"projectID"`
+
+	// ServiceAccount is the email of the service account that access was granted to.
+	// With IAM authentication, workloads impersonate this account through workload identity.
+	ServiceAccount string `json:"serviceAccount"`
+
+	// PrivateKeyName is the name of the private key of the service account in the OSP
+	PrivateKeyName string `json:"privateKeyName,omitempty"`
+
+	// PrivateKeyData is the JSON key file of the service account.
+	// It is empty with IAM authentication.
+	PrivateKeyData string `json:"privateKeyData,omitempty"`
+}
+
+type EncryptionInfo struct {
+	// Algorithm is the kind of server-side encryption of the bucket
+	Algorithm v1alpha1.EncryptionAlgorithm `json:"algorithm"`
+
+	// KMSKeyID is the ID of the key in the key management service of the OSP
+	KMSKeyID string `json:"kmsKeyID,omitempty"`
+
+	// Headers are the S3 request headers that clients must send to comply
+	// with the encryption of the bucket. With the CustomerKey algorithm they
+	// include the key, and must be sent with every request.
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
 // +k8s:deepcopy-gen=false
 type BucketInfo struct {
 	metav1.TypeMeta `json:",inline"`
@@ -54,12 +83,25 @@ type BucketInfoSpec struct {
 	// IAM - implicit authentication of pods to the OSP based on service account mappings
 	AuthenticationType v1alpha1.AuthenticationType `json:"authenticationType"`
 
+	// AccessMode is the level of access granted by the credentials
+	// It can be one of
+	// ReadOnly - objects can be listed and read
+	// ReadWrite - objects can be listed, read, written and deleted
+	// WriteOnly - objects can only be written
+	AccessMode v1alpha1.AccessMode `json:"accessMode,omitempty"`
+
 	// S3 - Details of S3 credentials
 	S3 *SecretS3 `json:"secretS3"`
 
 	// Azure - Details of Azure credentials
 	Azure *SecretAzure `json:"secretAzure"`
 
+	// GCS - Details of GCS credentials
+	GCS *SecretGCS `json:"secretGCS"`
+
+	// Encryption - Details of the server-side encryption of the bucket
+	Encryption *EncryptionInfo `json:"encryption,omitempty"`
+
 	// Protocols are the set of data APIs this bucket is expected to support.
 	// The possible values for protocol are:
 	// -  S3: Indicates Amazon S3 protocol
@@ -0,0 +1,72 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cosiapi
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"fmt"
+
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+)
+
+// S3 request headers for server-side encryption
+const (
+	HeaderSSE                  = "x-amz-server-side-encryption"
+	HeaderSSEKMSKeyID          = "x-amz-server-side-encryption-aws-kms-key-id"
+	HeaderSSECustomerAlgorithm = "x-amz-server-side-encryption-customer-algorithm"
+	HeaderSSECustomerKey       = "x-amz-server-side-encryption-customer-key"
+	HeaderSSECustomerKeyMD5    = "x-amz-server-side-encryption-customer-key-MD5"
+)
+
+// customerKeySize is the size in bytes of an SSE-C key
+const customerKeySize = 32
+
+// NewEncryptionInfo returns the EncryptionInfo to advertise in the BucketInfo
+// of a bucket with encryption. customerKey is the raw key read from the Secret
+// referred to by the encryption, and is only used with the CustomerKey algorithm.
+func NewEncryptionInfo(encryption *v1alpha1.BucketEncryption, customerKey []byte) (*EncryptionInfo, error) {
+	info := &EncryptionInfo{
+		Algorithm: encryption.Algorithm,
+		KMSKeyID:  encryption.KMSKeyID,
+	}
+
+	switch encryption.Algorithm {
+	case v1alpha1.EncryptionAlgorithmProviderManaged:
+		info.Headers = map[string]string{
+			HeaderSSE: "AES256",
+		}
+	case v1alpha1.EncryptionAlgorithmKMS:
+		info.Headers = map[string]string{
+			HeaderSSE:         "aws:kms",
+			HeaderSSEKMSKeyID: encryption.KMSKeyID,
+		}
+	case v1alpha1.EncryptionAlgorithmCustomerKey:
+		if len(customerKey) != customerKeySize {
+			return nil, fmt.Errorf("customer key must be %d bytes, got %d", customerKeySize, len(customerKey))
+		}
+		sum := md5.Sum(customerKey)
+		info.Headers = map[string]string{
+			HeaderSSECustomerAlgorithm: "AES256",
+			HeaderSSECustomerKey:       base64.StdEncoding.EncodeToString(customerKey),
+			HeaderSSECustomerKeyMD5:    base64.StdEncoding.EncodeToString(sum[:]),
+		}
+	default:
+		return nil, fmt.Errorf("unsupported encryption algorithm %q", encryption.Algorithm)
+	}
+	return info, nil
+}
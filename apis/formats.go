@@ -0,0 +1,295 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cosiapi
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+)
+
+// Environment variables understood by the AWS, Azure and Google Cloud SDKs and CLIs
+const (
+	EnvAWSAccessKeyID     = "AWS_ACCESS_KEY_ID"
+	EnvAWSSecretAccessKey = "AWS_SECRET_ACCESS_KEY"
+	EnvAWSRegion          = "AWS_REGION"
+	EnvAWSDefaultRegion   = "AWS_DEFAULT_REGION"
+	EnvAWSEndpointURL     = "AWS_ENDPOINT_URL"
+	EnvAzureSASToken      = "AZURE_STORAGE_SAS_TOKEN"
+	EnvGoogleCloudProject = "GOOGLE_CLOUD_PROJECT"
+
+	// EnvBucketName is set to the name of the bucket in the backend
+	EnvBucketName = "BUCKET_NAME"
+)
+
+var errNoS3 = errors.New("bucket info has no S3 credentials")
+
+// AWSCredentialsFile renders the S3 credentials as an AWS shared credentials
+// file with a single profile. With IAM authentication there are no keys to
+// render, and an error is returned.
+func (b *BucketInfo) AWSCredentialsFile(profile string) ([]byte, error) {
+	s3 := b.Spec.S3
+	if s3 == nil {
+		return nil, errNoS3
+	}
+	if s3.AccessKeyID == "" || s3.AccessSecretKey == "" {
+		return nil, fmt.Errorf("bucket info has no S3 keys for authentication type %q", b.Spec.AuthenticationType)
+	}
+
+	section := newIniSection(profile)
+	section.set("aws_access_key_id", s3.AccessKeyID)
+	section.set("aws_secret_access_key", s3.AccessSecretKey)
+	return section.render(), nil
+}
+
+// AWSConfigFile renders the S3 region and endpoint as an AWS config file with
+// a single profile
+func (b *BucketInfo) AWSConfigFile(profile string) ([]byte, error) {
+	s3 := b.Spec.S3
+	if s3 == nil {
+		return nil, errNoS3
+	}
+
+	section := newIniSection(awsConfigSectionName(profile))
+	section.set("region", s3.Region)
+	section.set("endpoint_url", s3.Endpoint)
+	return section.render(), nil
+}
+
+// ParseAWSConfigFiles reads the S3 credentials of a profile back from an AWS
+// shared credentials file and, optionally, an AWS config file
+func ParseAWSConfigFiles(credentials, config []byte, profile string) (*SecretS3, error) {
+	creds, err := parseIniSection(credentials, profile)
+	if err != nil {
+		return nil, fmt.Errorf("credentials file: %w", err)
+	}
+
+	s3 := &SecretS3{
+		AccessKeyID:     creds.get("aws_access_key_id"),
+		AccessSecretKey: creds.get("aws_secret_access_key"),
+	}
+	if len(config) > 0 {
+		section, err := parseIniSection(config, awsConfigSectionName(profile))
+		if err != nil {
+			return nil, fmt.Errorf("config file: %w", err)
+		}
+		s3.Region = section.get("region")
+		s3.Endpoint = section.get("endpoint_url")
+	}
+	return s3, nil
+}
+
+// awsConfigSectionName returns the section of a profile in the AWS config
+// file, where all but the default profile are prefixed
+func awsConfigSectionName(profile string) string {
+	if profile == "default" {
+		return profile
+	}
+	return "profile " + profile
+}
+
+// Env returns the environment variables that configure the AWS, Azure and
+// Google Cloud SDKs for the bucket. Only the variables for which the bucket
+// info has values are set.
+func (b *BucketInfo) Env() map[string]string {
+	env := map[string]string{}
+	set := func(key, value string) {
+		if value != "" {
+			env[key] = value
+		}
+	}
+
+	set(EnvBucketName, b.Spec.BucketName)
+	if s3 := b.Spec.S3; s3 != nil {
+		set(EnvAWSAccessKeyID, s3.AccessKeyID)
+		set(EnvAWSSecretAccessKey, s3.AccessSecretKey)
+		set(EnvAWSRegion, s3.Region)
+		set(EnvAWSDefaultRegion, s3.Region)
+		set(EnvAWSEndpointURL, s3.Endpoint)
+	}
+	if azure := b.Spec.Azure; azure != nil {
+		set(EnvAzureSASToken, azure.AccessToken)
+	}
+	if gcs := b.Spec.GCS; gcs != nil {
+		set(EnvGoogleCloudProject, gcs.ProjectID)
+	}
+	return env
+}
+
+// EnvList returns Env as a sorted list of KEY=value pairs, as used by os/exec
+func (b *BucketInfo) EnvList() []string {
+	env := b.Env()
+	list := make([]string, 0, len(env))
+	for key, value := range env {
+		list = append(list, key+"="+value)
+	}
+	sort.Strings(list)
+	return list
+}
+
+// ParseEnv reads the credentials back from environment variables as returned
+// by Env. Protocols for which no variables are set are left nil.
+func ParseEnv(env map[string]string) *BucketInfoSpec {
+	spec := &BucketInfoSpec{BucketName: env[EnvBucketName]}
+
+	if env[EnvAWSAccessKeyID] != "" || env[EnvAWSEndpointURL] != "" {
+		spec.S3 = &SecretS3{
+			Endpoint:        env[EnvAWSEndpointURL],
+			Region:          env[EnvAWSRegion],
+			AccessKeyID:     env[EnvAWSAccessKeyID],
+			AccessSecretKey: env[EnvAWSSecretAccessKey],
+		}
+		if spec.S3.Region == "" {
+			spec.S3.Region = env[EnvAWSDefaultRegion]
+		}
+		spec.Protocols = append(spec.Protocols, v1alpha1.ProtocolS3)
+	}
+	if env[EnvAzureSASToken] != "" {
+		spec.Azure = &SecretAzure{AccessToken: env[EnvAzureSASToken]}
+		spec.Protocols = append(spec.Protocols, v1alpha1.ProtocolAzure)
+	}
+	if env[EnvGoogleCloudProject] != "" {
+		spec.GCS = &SecretGCS{ProjectID: env[EnvGoogleCloudProject]}
+		spec.Protocols = append(spec.Protocols, v1alpha1.ProtocolGCP)
+	}
+	return spec
+}
+
+// RcloneConfig renders the credentials as an rclone remote called remote.
+// S3 is preferred if the bucket supports several protocols. Azure is not
+// supported, as the bucket info carries a SAS token but not the account URL
+// that rclone requires.
+func (b *BucketInfo) RcloneConfig(remote string) ([]byte, error) {
+	section := newIniSection(remote)
+
+	switch {
+	case b.Spec.S3 != nil:
+		s3 := b.Spec.S3
+		section.set("type", "s3")
+		section.set("provider", "Other")
+		if b.Spec.AuthenticationType == v1alpha1.AuthenticationTypeIAM {
+			section.set("env_auth", "true")
+		}
+		section.set("access_key_id", s3.AccessKeyID)
+		section.set("secret_access_key", s3.AccessSecretKey)
+		section.set("region", s3.Region)
+		section.set("endpoint", s3.Endpoint)
+	case b.Spec.GCS != nil:
+		gcs := b.Spec.GCS
+		section.set("type", "google cloud storage")
+		if gcs.PrivateKeyData == "" {
+			section.set("env_auth", "true")
+		} else {
+			key, err := gcs.ServiceAccountKey()
+			if err != nil {
+				return nil, err
+			}
+			// rclone reads values from a single line
+			section.set("service_account_credentials", strings.Join(strings.Fields(string(key)), " "))
+		}
+	case b.Spec.Azure != nil:
+		return nil, errors.New("rclone remotes for Azure require the storage account URL, which bucket info does not carry")
+	default:
+		return nil, errors.New("bucket info has no credentials")
+	}
+	return section.render(), nil
+}
+
+// ParseRcloneConfig reads the S3 credentials back from the remote called
+// remote in an rclone config file
+func ParseRcloneConfig(data []byte, remote string) (*SecretS3, error) {
+	section, err := parseIniSection(data, remote)
+	if err != nil {
+		return nil, err
+	}
+	if t := section.get("type"); t != "s3" {
+		return nil, fmt.Errorf("remote %q has type %q, only s3 is supported", remote, t)
+	}
+
+	return &SecretS3{
+		Endpoint:        section.get("endpoint"),
+		Region:          section.get("region"),
+		AccessKeyID:     section.get("access_key_id"),
+		AccessSecretKey: section.get("secret_access_key"),
+	}, nil
+}
+
+// S3cmdConfig renders the S3 credentials as an s3cmd .s3cfg file
+func (b *BucketInfo) S3cmdConfig() ([]byte, error) {
+	s3 := b.Spec.S3
+	if s3 == nil {
+		return nil, errNoS3
+	}
+
+	section := newIniSection("default")
+	section.set("access_key", s3.AccessKeyID)
+	section.set("secret_key", s3.AccessSecretKey)
+	section.set("bucket_location", s3.Region)
+	if s3.Endpoint != "" {
+		host, https, err := splitEndpoint(s3.Endpoint)
+		if err != nil {
+			return nil, err
+		}
+		section.set("host_base", host)
+		section.set("host_bucket", host)
+		if https {
+			section.set("use_https", "True")
+		} else {
+			section.set("use_https", "False")
+		}
+	}
+	return section.render(), nil
+}
+
+// ParseS3cmdConfig reads the S3 credentials back from an s3cmd .s3cfg file
+func ParseS3cmdConfig(data []byte) (*SecretS3, error) {
+	section, err := parseIniSection(data, "default")
+	if err != nil {
+		return nil, err
+	}
+
+	s3 := &SecretS3{
+		Region:          section.get("bucket_location"),
+		AccessKeyID:     section.get("access_key"),
+		AccessSecretKey: section.get("secret_key"),
+	}
+	if host := section.get("host_base"); host != "" {
+		scheme := "https"
+		if strings.EqualFold(section.get("use_https"), "false") {
+			scheme = "http"
+		}
+		s3.Endpoint = scheme + "://" + host
+	}
+	return s3, nil
+}
+
+// splitEndpoint returns the host of an S3 endpoint and whether it uses https.
+// Endpoints without a scheme are assumed to use https.
+func splitEndpoint(endpoint string) (string, bool, error) {
+	if !strings.Contains(endpoint, "://") {
+		return endpoint, true, nil
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", false, fmt.Errorf("invalid S3 endpoint %q: %w", endpoint, err)
+	}
+	return u.Host, u.Scheme != "http", nil
+}
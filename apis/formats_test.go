@@ -0,0 +1,382 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cosiapi
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+func s3BucketInfo() *BucketInfo {
+	return &BucketInfo{
+		Spec: BucketInfoSpec{
+			BucketName:         "bucket-1234",
+			AuthenticationType: v1alpha1.AuthenticationTypeKey,
+			S3: &SecretS3{
+				Endpoint:        "https://s3.example.com:9000",
+				Region:          "us-east-1",
+				AccessKeyID:     "AKIAEXAMPLE",
+				AccessSecretKey: "secret/key+example",
+			},
+			Protocols: []v1alpha1.Protocol{v1alpha1.ProtocolS3},
+		},
+	}
+}
+
+func gcsBucketInfo() *BucketInfo {
+	return &BucketInfo{
+		Spec: BucketInfoSpec{
+			BucketName:         "bucket-1234",
+			AuthenticationType: v1alpha1.AuthenticationTypeKey,
+			GCS: &SecretGCS{
+				ProjectID:      "project-1234",
+				ServiceAccount: "cosi@project-1234.iam.gserviceaccount.com",
+				PrivateKeyName: "key-1234",
+				PrivateKeyData: "{\n  \"type\": \"service_account\",\n  \"project_id\": \"project-1234\"\n}\n",
+			},
+			Protocols: []v1alpha1.Protocol{v1alpha1.ProtocolGCP},
+		},
+	}
+}
+
+// checkGolden compares got with the file called name in testdata, or writes
+// it there when the tests are run with -update
+func checkGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(path, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s differs from the golden file, got:\n%s\nwant:\n%s", name, got, want)
+	}
+}
+
+func TestFormats(t *testing.T) {
+	iam := s3BucketInfo()
+	iam.Spec.AuthenticationType = v1alpha1.AuthenticationTypeIAM
+	iam.Spec.S3.AccessKeyID = ""
+	iam.Spec.S3.AccessSecretKey = ""
+
+	workloadIdentity := gcsBucketInfo()
+	workloadIdentity.Spec.AuthenticationType = v1alpha1.AuthenticationTypeIAM
+	workloadIdentity.Spec.GCS.PrivateKeyName = ""
+	workloadIdentity.Spec.GCS.PrivateKeyData = ""
+
+	tests := []struct {
+		golden string
+		render func() ([]byte, error)
+	}{
+		{
+			golden: "s3.env",
+			render: func() ([]byte, error) {
+				return []byte(strings.Join(s3BucketInfo().EnvList(), "\n") + "\n"), nil
+			},
+		},
+		{
+			golden: "gcs.env",
+			render: func() ([]byte, error) {
+				return []byte(strings.Join(gcsBucketInfo().EnvList(), "\n") + "\n"), nil
+			},
+		},
+		{
+			golden: "aws-credentials.ini",
+			render: func() ([]byte, error) { return s3BucketInfo().AWSCredentialsFile("cosi") },
+		},
+		{
+			golden: "aws-config.ini",
+			render: func() ([]byte, error) { return s3BucketInfo().AWSConfigFile("cosi") },
+		},
+		{
+			golden: "aws-config-default.ini",
+			render: func() ([]byte, error) { return s3BucketInfo().AWSConfigFile("default") },
+		},
+		{
+			golden: "s3.rclone.conf",
+			render: func() ([]byte, error) { return s3BucketInfo().RcloneConfig("cosi") },
+		},
+		{
+			golden: "s3-iam.rclone.conf",
+			render: func() ([]byte, error) { return iam.RcloneConfig("cosi") },
+		},
+		{
+			golden: "gcs.rclone.conf",
+			render: func() ([]byte, error) { return gcsBucketInfo().RcloneConfig("cosi") },
+		},
+		{
+			golden: "gcs-iam.rclone.conf",
+			render: func() ([]byte, error) { return workloadIdentity.RcloneConfig("cosi") },
+		},
+		{
+			golden: "s3cmd.s3cfg",
+			render: func() ([]byte, error) { return s3BucketInfo().S3cmdConfig() },
+		},
+		{
+			golden: "s3.json",
+			render: func() ([]byte, error) { return s3BucketInfo().Marshal() },
+		},
+		{
+			golden: "gcs.json",
+			render: func() ([]byte, error) { return gcsBucketInfo().Marshal() },
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.golden, func(t *testing.T) {
+			got, err := test.render()
+			if err != nil {
+				t.Fatal(err)
+			}
+			checkGolden(t, test.golden, got)
+		})
+	}
+}
+
+func TestFormatErrors(t *testing.T) {
+	iam := s3BucketInfo()
+	iam.Spec.S3.AccessKeyID = ""
+	azure := &BucketInfo{Spec: BucketInfoSpec{Azure: &SecretAzure{AccessToken: "token"}}}
+
+	tests := []struct {
+		name   string
+		render func() ([]byte, error)
+	}{
+		{"credentials without S3", func() ([]byte, error) { return gcsBucketInfo().AWSCredentialsFile("cosi") }},
+		{"credentials without keys", func() ([]byte, error) { return iam.AWSCredentialsFile("cosi") }},
+		{"config without S3", func() ([]byte, error) { return gcsBucketInfo().AWSConfigFile("cosi") }},
+		{"rclone for Azure", func() ([]byte, error) { return azure.RcloneConfig("cosi") }},
+		{"rclone without credentials", func() ([]byte, error) { return (&BucketInfo{}).RcloneConfig("cosi") }},
+		{"s3cmd without S3", func() ([]byte, error) { return gcsBucketInfo().S3cmdConfig() }},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := test.render(); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestParseAWSConfigFiles(t *testing.T) {
+	credentials := []byte(`# written by hand
+[default]
+aws_access_key_id = AKIADEFAULT
+aws_secret_access_key = default
+
+[cosi]
+aws_access_key_id=AKIAEXAMPLE
+aws_secret_access_key = secret/key+example
+`)
+	config := []byte(`[default]
+region = eu-west-1
+
+[profile cosi]
+region = us-east-1
+endpoint_url = https://s3.example.com:9000
+s3 =
+  addressing_style = path
+`)
+
+	got, err := ParseAWSConfigFiles(credentials, config, "cosi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := s3BucketInfo().Spec.S3; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	got, err = ParseAWSConfigFiles(credentials, nil, "default")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (&SecretS3{AccessKeyID: "AKIADEFAULT", AccessSecretKey: "default"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	if _, err := ParseAWSConfigFiles(credentials, config, "missing"); err == nil {
+		t.Error("expected an error for a missing profile")
+	}
+	if _, err := ParseAWSConfigFiles([]byte("[cosi]\nnot a key value pair\n"), nil, "cosi"); err == nil {
+		t.Error("expected an error for an invalid line")
+	}
+}
+
+func TestParseEnv(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want *BucketInfoSpec
+	}{
+		{
+			name: "S3",
+			env:  s3BucketInfo().Env(),
+			want: &BucketInfoSpec{
+				BucketName: "bucket-1234",
+				S3:         s3BucketInfo().Spec.S3,
+				Protocols:  []v1alpha1.Protocol{v1alpha1.ProtocolS3},
+			},
+		},
+		{
+			name: "S3 with default region only",
+			env: map[string]string{
+				EnvAWSEndpointURL:   "https://s3.example.com",
+				EnvAWSDefaultRegion: "eu-west-1",
+			},
+			want: &BucketInfoSpec{
+				S3:        &SecretS3{Endpoint: "https://s3.example.com", Region: "eu-west-1"},
+				Protocols: []v1alpha1.Protocol{v1alpha1.ProtocolS3},
+			},
+		},
+		{
+			name: "Azure and GCS",
+			env: map[string]string{
+				EnvBucketName:         "bucket-1234",
+				EnvAzureSASToken:      "token",
+				EnvGoogleCloudProject: "project-1234",
+			},
+			want: &BucketInfoSpec{
+				BucketName: "bucket-1234",
+				Azure:      &SecretAzure{AccessToken: "token"},
+				GCS:        &SecretGCS{ProjectID: "project-1234"},
+				Protocols:  []v1alpha1.Protocol{v1alpha1.ProtocolAzure, v1alpha1.ProtocolGCP},
+			},
+		},
+		{
+			name: "empty",
+			env:  map[string]string{},
+			want: &BucketInfoSpec{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := ParseEnv(test.env); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseRcloneConfig(t *testing.T) {
+	rendered, err := s3BucketInfo().RcloneConfig("cosi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := append([]byte("[other]\ntype = s3\n\n"), rendered...)
+
+	got, err := ParseRcloneConfig(data, "cosi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := s3BucketInfo().Spec.S3; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	gcs, err := gcsBucketInfo().RcloneConfig("cosi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ParseRcloneConfig(gcs, "cosi"); err == nil {
+		t.Error("expected an error for a google cloud storage remote")
+	}
+	if _, err := ParseRcloneConfig(data, "missing"); err == nil {
+		t.Error("expected an error for a missing remote")
+	}
+}
+
+func TestParseS3cmdConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want *SecretS3
+	}{
+		{
+			name: "rendered",
+			data: func() string {
+				data, err := s3BucketInfo().S3cmdConfig()
+				if err != nil {
+					t.Fatal(err)
+				}
+				return string(data)
+			}(),
+			want: s3BucketInfo().Spec.S3,
+		},
+		{
+			name: "http",
+			data: "[default]\naccess_key = AKIAEXAMPLE\nsecret_key = secret\nhost_base = localhost:9000\nuse_https = False\n",
+			want: &SecretS3{Endpoint: "http://localhost:9000", AccessKeyID: "AKIAEXAMPLE", AccessSecretKey: "secret"},
+		},
+		{
+			name: "no endpoint",
+			data: "; AWS\n[default]\naccess_key = AKIAEXAMPLE\nsecret_key = secret\nbucket_location = us-east-1\n",
+			want: &SecretS3{Region: "us-east-1", AccessKeyID: "AKIAEXAMPLE", AccessSecretKey: "secret"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseS3cmdConfig([]byte(test.data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestUnmarshalBucketInfo(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "s3.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := BucketInfoFromSecretData(map[string][]byte{BucketInfoSecretKey: data})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := s3BucketInfo()
+	want.Kind = BucketInfoKind
+	want.APIVersion = v1alpha1.SchemeGroupVersion.String()
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	if _, err := UnmarshalBucketInfo([]byte(`{"kind":"Secret"}`)); err == nil {
+		t.Error("expected an error for an unexpected kind")
+	}
+	if _, err := BucketInfoFromSecretData(map[string][]byte{}); err == nil {
+		t.Error("expected an error for a secret without bucket info")
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cosiapi
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// iniSection is a named section of an INI style configuration file. Keys keep
+// the order in which they were set, so that rendered files are stable.
+type iniSection struct {
+	name   string
+	keys   []string
+	values map[string]string
+}
+
+func newIniSection(name string) *iniSection {
+	return &iniSection{name: name, values: map[string]string{}}
+}
+
+// set adds key to the section, skipping empty values
+func (s *iniSection) set(key, value string) {
+	if value == "" {
+		return
+	}
+	if _, ok := s.values[key]; !ok {
+		s.keys = append(s.keys, key)
+	}
+	s.values[key] = value
+}
+
+func (s *iniSection) get(key string) string {
+	return s.values[key]
+}
+
+func (s *iniSection) render() []byte {
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "[%s]\n", s.name)
+	for _, key := range s.keys {
+		fmt.Fprintf(&out, "%s = %s\n", key, s.values[key])
+	}
+	return out.Bytes()
+}
+
+// parseIniSection returns the section called name from an INI style file.
+// Comments starting with # or ; are ignored, as are nested values such as
+// the s3 block of an AWS config file.
+func parseIniSection(data []byte, name string) (*iniSection, error) {
+	var section *iniSection
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		raw := scanner.Text()
+		line := strings.TrimSpace(raw)
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+			continue
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			if section != nil {
+				return section, nil
+			}
+			if strings.TrimSpace(line[1:len(line)-1]) == name {
+				section = newIniSection(name)
+			}
+		case section != nil && raw[0] != ' ' && raw[0] != '\t':
+			key, value, ok := strings.Cut(line, "=")
+			if !ok {
+				return nil, fmt.Errorf("invalid line in section [%s]: %q", name, line)
+			}
+			section.set(strings.TrimSpace(key), strings.TrimSpace(value))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if section == nil {
+		return nil, fmt.Errorf("section [%s] not found", name)
+	}
+	return section, nil
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cosiapi
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+)
+
+const (
+	// BucketInfoKind is the kind written to the BucketInfo in the credentials secret
+	BucketInfoKind = "BucketInfo"
+
+	// BucketInfoSecretKey is the key of the credentials secret holding the BucketInfo
+	BucketInfoSecretKey = "BucketInfo"
+)
+
+// Marshal returns the JSON encoding of the BucketInfo as it is stored in the
+// credentials secret. The kind and apiVersion are filled in if unset.
+func (b *BucketInfo) Marshal() ([]byte, error) {
+	info := *b
+	if info.Kind == "" {
+		info.Kind = BucketInfoKind
+	}
+	if info.APIVersion == "" {
+		info.APIVersion = v1alpha1.SchemeGroupVersion.String()
+	}
+	return json.Marshal(&info)
+}
+
+// UnmarshalBucketInfo decodes a BucketInfo from the contents of the credentials
+// secret. Only the credentials for the protocols of the bucket are expected to
+// be set.
+func UnmarshalBucketInfo(data []byte) (*BucketInfo, error) {
+	info := &BucketInfo{}
+	if err := json.Unmarshal(data, info); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", BucketInfoKind, err)
+	}
+	if info.Kind != "" && info.Kind != BucketInfoKind {
+		return nil, fmt.Errorf("unexpected kind %q, expected %q", info.Kind, BucketInfoKind)
+	}
+	return info, nil
+}
+
+// BucketInfoFromSecretData decodes the BucketInfo stored under
+// BucketInfoSecretKey in the data of a credentials secret
+func BucketInfoFromSecretData(data map[string][]byte) (*BucketInfo, error) {
+	raw, ok := data[BucketInfoSecretKey]
+	if !ok {
+		return nil, fmt.Errorf("secret has no %q key", BucketInfoSecretKey)
+	}
+	return UnmarshalBucketInfo(raw)
+}
+
+// SecretData returns the BucketInfo encoded as the data of a credentials secret
+func (b *BucketInfo) SecretData() (map[string][]byte, error) {
+	raw, err := b.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return map[string][]byte{BucketInfoSecretKey: raw}, nil
+}
+
+// ServiceAccountKey returns the JSON key file of the service account, or nil
+// if the credentials do not include one, such as with workload identity
+func (s *SecretGCS) ServiceAccountKey() ([]byte, error) {
+	if s.PrivateKeyData == "" {
+		return nil, nil
+	}
+	if !json.Valid([]byte(s.PrivateKeyData)) {
+		return nil, fmt.Errorf("privateKeyData of service account %q is not valid JSON", s.ServiceAccount)
+	}
+	return []byte(s.PrivateKeyData), nil
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// v1alpha1 is the storage version and the hub that the other versions of the
+// API are converted to and from.
+
+// Hub marks Bucket as a conversion hub
+func (*Bucket) Hub() {}
+
+// Hub marks BucketClaim as a conversion hub
+func (*BucketClaim) Hub() {}
+
+// Hub marks BucketClaimGrant as a conversion hub
+func (*BucketClaimGrant) Hub() {}
+
+// Hub marks BucketClass as a conversion hub
+func (*BucketClass) Hub() {}
+
+// Hub marks BucketAccessClass as a conversion hub
+func (*BucketAccessClass) Hub() {}
+
+// Hub marks BucketAccess as a conversion hub
+func (*BucketAccess) Hub() {}
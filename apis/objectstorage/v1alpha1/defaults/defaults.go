@@ -0,0 +1,93 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package defaults finds the classes that are marked as the default with the
+// v1alpha1.IsDefaultClassAnnotation annotation. It is shared by the admission
+// webhook and by anything else that needs to resolve an omitted class name.
+package defaults
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+	bucketlisters "sigs.k8s.io/container-object-storage-interface-api/client/listers/objectstorage/v1alpha1"
+)
+
+// IsDefaultClass returns true if the annotations of a class mark it as the default
+func IsDefaultClass(annotations map[string]string) bool {
+	return annotations[v1alpha1.IsDefaultClassAnnotation] == "true"
+}
+
+// DefaultBucketClass returns the BucketClass marked as default, or nil if there
+// is none. It is an error for more than one BucketClass to be marked as default.
+func DefaultBucketClass(lister bucketlisters.BucketClassLister) (*v1alpha1.BucketClass, error) {
+	classes, err := lister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	defaults := []*v1alpha1.BucketClass{}
+	names := []string{}
+	for _, class := range classes {
+		if IsDefaultClass(class.Annotations) {
+			defaults = append(defaults, class)
+			names = append(names, class.Name)
+		}
+	}
+
+	switch len(defaults) {
+	case 0:
+		return nil, nil
+	case 1:
+		return defaults[0], nil
+	default:
+		sort.Strings(names)
+		return nil, fmt.Errorf("%d BucketClasses are marked as default: %s", len(defaults), strings.Join(names, ", "))
+	}
+}
+
+// DefaultBucketAccessClass returns the BucketAccessClass marked as default, or nil
+// if there is none. It is an error for more than one BucketAccessClass to be marked
+// as default.
+func DefaultBucketAccessClass(lister bucketlisters.BucketAccessClassLister) (*v1alpha1.BucketAccessClass, error) {
+	classes, err := lister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	defaults := []*v1alpha1.BucketAccessClass{}
+	names := []string{}
+	for _, class := range classes {
+		if IsDefaultClass(class.Annotations) {
+			defaults = append(defaults, class)
+			names = append(names, class.Name)
+		}
+	}
+
+	switch len(defaults) {
+	case 0:
+		return nil, nil
+	case 1:
+		return defaults[0], nil
+	default:
+		sort.Strings(names)
+		return nil, fmt.Errorf("%d BucketAccessClasses are marked as default: %s", len(defaults), strings.Join(names, ", "))
+	}
+}
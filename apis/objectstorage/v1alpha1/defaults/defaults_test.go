@@ -0,0 +1,151 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaults
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+	bucketlisters "sigs.k8s.io/container-object-storage-interface-api/client/listers/objectstorage/v1alpha1"
+)
+
+func classMeta(name string, isDefault string) metav1.ObjectMeta {
+	meta := metav1.ObjectMeta{Name: name}
+	if isDefault != "" {
+		meta.Annotations = map[string]string{v1alpha1.IsDefaultClassAnnotation: isDefault}
+	}
+	return meta
+}
+
+func TestDefaultBucketClass(t *testing.T) {
+	testCases := []struct {
+		name        string
+		classes     []*v1alpha1.BucketClass
+		expected    string
+		expectedErr string
+	}{
+		{
+			name: "no classes",
+		},
+		{
+			name: "no default",
+			classes: []*v1alpha1.BucketClass{
+				{ObjectMeta: classMeta("a", "")},
+				{ObjectMeta: classMeta("b", "false")},
+			},
+		},
+		{
+			name: "single default",
+			classes: []*v1alpha1.BucketClass{
+				{ObjectMeta: classMeta("a", "")},
+				{ObjectMeta: classMeta("b", "true")},
+				{ObjectMeta: classMeta("c", "True")},
+			},
+			expected: "b",
+		},
+		{
+			name: "multiple defaults",
+			classes: []*v1alpha1.BucketClass{
+				{ObjectMeta: classMeta("c", "true")},
+				{ObjectMeta: classMeta("b", "")},
+				{ObjectMeta: classMeta("a", "true")},
+			},
+			expectedErr: "2 BucketClasses are marked as default: a, c",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			for _, class := range tc.classes {
+				indexer.Add(class)
+			}
+
+			class, err := DefaultBucketClass(bucketlisters.NewBucketClassLister(indexer))
+			name := ""
+			if class != nil {
+				name = class.Name
+			}
+			checkDefault(t, name, err, tc.expected, tc.expectedErr)
+		})
+	}
+}
+
+func TestDefaultBucketAccessClass(t *testing.T) {
+	testCases := []struct {
+		name        string
+		classes     []*v1alpha1.BucketAccessClass
+		expected    string
+		expectedErr string
+	}{
+		{
+			name:    "no default",
+			classes: []*v1alpha1.BucketAccessClass{{ObjectMeta: classMeta("a", "")}},
+		},
+		{
+			name: "single default",
+			classes: []*v1alpha1.BucketAccessClass{
+				{ObjectMeta: classMeta("a", "true")},
+				{ObjectMeta: classMeta("b", "")},
+			},
+			expected: "a",
+		},
+		{
+			name: "multiple defaults",
+			classes: []*v1alpha1.BucketAccessClass{
+				{ObjectMeta: classMeta("b", "true")},
+				{ObjectMeta: classMeta("a", "true")},
+			},
+			expectedErr: "2 BucketAccessClasses are marked as default: a, b",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			for _, class := range tc.classes {
+				indexer.Add(class)
+			}
+
+			class, err := DefaultBucketAccessClass(bucketlisters.NewBucketAccessClassLister(indexer))
+			name := ""
+			if class != nil {
+				name = class.Name
+			}
+			checkDefault(t, name, err, tc.expected, tc.expectedErr)
+		})
+	}
+}
+
+func checkDefault(t *testing.T, name string, err error, expected, expectedErr string) {
+	t.Helper()
+	if expectedErr != "" {
+		if err == nil || err.Error() != expectedErr {
+			t.Fatalf("expected error %q, got %v", expectedErr, err)
+		}
+		return
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if name != expected {
+		t.Errorf("expected default %q, got %q", expected, name)
+	}
+}
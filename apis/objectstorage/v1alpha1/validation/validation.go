@@ -0,0 +1,585 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package validation contains the validation rules for the objectstorage
+// v1alpha1 API. The functions in this package do not talk to the API server
+// and can be used offline as well as by the admission webhook.
+//
+// Update validators ratchet the rules applied on creation: an update is only
+// rejected for a violation that it introduced, so that objects stored before a
+// rule existed can still be updated. In particular, updates that change only
+// metadata or status, such as removing a finalizer, are always allowed.
+package validation
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+)
+
+var (
+	supportedProtocols = []string{
+		string(v1alpha1.ProtocolS3),
+		string(v1alpha1.ProtocolAzure),
+		string(v1alpha1.ProtocolGCP),
+	}
+
+	supportedDeletionPolicies = []string{
+		string(v1alpha1.DeletionPolicyRetain),
+		string(v1alpha1.DeletionPolicyDelete),
+	}
+
+	supportedAuthenticationTypes = []string{
+		string(v1alpha1.AuthenticationTypeKey),
+		string(v1alpha1.AuthenticationTypeIAM),
+	}
+
+	supportedObjectLockModes = []string{
+		string(v1alpha1.ObjectLockModeGovernance),
+		string(v1alpha1.ObjectLockModeCompliance),
+	}
+
+	supportedEncryptionAlgorithms = []string{
+		string(v1alpha1.EncryptionAlgorithmProviderManaged),
+		string(v1alpha1.EncryptionAlgorithmKMS),
+		string(v1alpha1.EncryptionAlgorithmCustomerKey),
+	}
+
+	supportedAccessModes = []string{
+		string(v1alpha1.AccessModeReadOnly),
+		string(v1alpha1.AccessModeReadWrite),
+		string(v1alpha1.AccessModeWriteOnly),
+	}
+)
+
+const immutableFieldMsg = "field is immutable"
+
+// ValidateBucket validates a Bucket on creation
+func ValidateBucket(bucket *v1alpha1.Bucket) field.ErrorList {
+	allErrs := field.ErrorList{}
+	specPath := field.NewPath("spec")
+
+	if bucket.Spec.DriverName == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("driverName"), ""))
+	}
+	if ref := bucket.Spec.BucketClaim; ref == nil {
+		allErrs = append(allErrs, field.Required(specPath.Child("bucketClaim"), ""))
+	} else {
+		if ref.Name == "" {
+			allErrs = append(allErrs, field.Required(specPath.Child("bucketClaim", "name"), ""))
+		}
+		if ref.Namespace == "" {
+			allErrs = append(allErrs, field.Required(specPath.Child("bucketClaim", "namespace"), ""))
+		}
+	}
+	allErrs = append(allErrs, validateProtocols(bucket.Spec.Protocols, specPath.Child("protocols"))...)
+	allErrs = append(allErrs, validateDeletionPolicy(bucket.Spec.DeletionPolicy, specPath.Child("deletionPolicy"))...)
+	allErrs = append(allErrs, validateQuota(bucket.Spec.Quota, specPath.Child("quota"))...)
+	allErrs = append(allErrs, validateFeatures(bucket.Spec.Features, specPath.Child("features"))...)
+	allErrs = append(allErrs, ValidateLifecycleRules(bucket.Spec.LifecycleRules, versioningEnabled(bucket.Spec.Features), specPath.Child("lifecycleRules"))...)
+	allErrs = append(allErrs, validateEncryption(bucket.Spec.Encryption, specPath.Child("encryption"))...)
+
+	return allErrs
+}
+
+// ValidateBucketUpdate validates an update of a Bucket
+func ValidateBucketUpdate(old, new *v1alpha1.Bucket) field.ErrorList {
+	allErrs := ratchet(ValidateBucket(new), ValidateBucket(old))
+	specPath := field.NewPath("spec")
+
+	if old.Spec.DriverName != new.Spec.DriverName {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("driverName"), new.Spec.DriverName, immutableFieldMsg))
+	}
+	if old.Spec.BucketClassName != new.Spec.BucketClassName {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("bucketClassName"), new.Spec.BucketClassName, immutableFieldMsg))
+	}
+	// the uid of the claim is filled in when a statically provisioned bucket is bound
+	if oldRef, newRef := old.Spec.BucketClaim, new.Spec.BucketClaim; oldRef != nil && newRef != nil {
+		if oldRef.Name != newRef.Name || oldRef.Namespace != newRef.Namespace || (oldRef.UID != "" && oldRef.UID != newRef.UID) {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("bucketClaim"), newRef, immutableFieldMsg))
+		}
+	}
+	allErrs = append(allErrs, validateFeaturesUpdate(old.Spec.Features, new.Spec.Features, specPath.Child("features"))...)
+	if old.Spec.Encryption != nil && new.Spec.Encryption == nil {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("encryption"), "cannot be removed once set"))
+	}
+
+	return allErrs
+}
+
+// ValidateBucketClaim validates a BucketClaim on creation
+func ValidateBucketClaim(claim *v1alpha1.BucketClaim) field.ErrorList {
+	allErrs := field.ErrorList{}
+	specPath := field.NewPath("spec")
+
+	allErrs = append(allErrs, validateProtocols(claim.Spec.Protocols, specPath.Child("protocols"))...)
+	allErrs = append(allErrs, validateQuota(claim.Spec.Quota, specPath.Child("quota"))...)
+
+	return allErrs
+}
+
+// ValidateBucketClaimUpdate validates an update of a BucketClaim
+func ValidateBucketClaimUpdate(old, new *v1alpha1.BucketClaim) field.ErrorList {
+	allErrs := ratchet(ValidateBucketClaim(new), ValidateBucketClaim(old))
+	specPath := field.NewPath("spec")
+
+	if old.Spec.BucketClassName != new.Spec.BucketClassName {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("bucketClassName"), new.Spec.BucketClassName, immutableFieldMsg))
+	}
+	if old.Spec.ExistingBucketName != new.Spec.ExistingBucketName {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("existingBucketName"), new.Spec.ExistingBucketName, immutableFieldMsg))
+	}
+
+	return allErrs
+}
+
+// ValidateBucketClaimReference validates that a Bucket and the BucketClaim it
+// is bound to refer to each other. It is used to check a Bucket and BucketClaim
+// that import a bucket created outside of COSI before they are created.
+func ValidateBucketClaimReference(bucket *v1alpha1.Bucket, claim *v1alpha1.BucketClaim) field.ErrorList {
+	allErrs := field.ErrorList{}
+	refPath := field.NewPath("spec", "bucketClaim")
+
+	ref := bucket.Spec.BucketClaim
+	if ref == nil {
+		return append(allErrs, field.Required(refPath, ""))
+	}
+	if ref.Kind != "" && ref.Kind != "BucketClaim" {
+		allErrs = append(allErrs, field.Invalid(refPath.Child("kind"), ref.Kind, "must be BucketClaim"))
+	}
+	if ref.Name != claim.Name {
+		allErrs = append(allErrs, field.Invalid(refPath.Child("name"), ref.Name, "must match the name of the BucketClaim"))
+	}
+	if ref.Namespace != claim.Namespace {
+		allErrs = append(allErrs, field.Invalid(refPath.Child("namespace"), ref.Namespace, "must match the namespace of the BucketClaim"))
+	}
+	if ref.UID != "" && claim.UID != "" && ref.UID != claim.UID {
+		allErrs = append(allErrs, field.Invalid(refPath.Child("uid"), ref.UID, "must match the uid of the BucketClaim"))
+	}
+	if claim.Spec.ExistingBucketName != bucket.Name {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "existingBucketName"), claim.Spec.ExistingBucketName, "must match the name of the Bucket"))
+	}
+	for i, protocol := range claim.Spec.Protocols {
+		if !containsProtocol(bucket.Spec.Protocols, protocol) {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "protocols").Index(i), protocol, "is not supported by the Bucket"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateBucketAccess validates a BucketAccess on creation
+func ValidateBucketAccess(access *v1alpha1.BucketAccess) field.ErrorList {
+	allErrs := field.ErrorList{}
+	specPath := field.NewPath("spec")
+
+	if access.Spec.BucketClaimName == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("bucketClaimName"), ""))
+	}
+	if ns := access.Spec.BucketClaimNamespace; ns != "" {
+		allErrs = append(allErrs, validateNamespace(ns, specPath.Child("bucketClaimNamespace"))...)
+	}
+	if access.Spec.BucketAccessClassName == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("bucketAccessClassName"), ""))
+	}
+	if access.Spec.CredentialsSecretName == "" && access.Spec.ServiceAccountName == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("credentialsSecretName"), "credentialsSecretName or serviceAccountName (for IAM authentication) must be set"))
+	}
+	if access.Spec.Protocol != "" {
+		allErrs = append(allErrs, validateProtocol(access.Spec.Protocol, specPath.Child("protocol"))...)
+	}
+	allErrs = append(allErrs, validateAccessMode(access.Spec.AccessMode, specPath.Child("accessMode"))...)
+
+	return allErrs
+}
+
+// ValidateBucketAccessUpdate validates an update of a BucketAccess
+func ValidateBucketAccessUpdate(old, new *v1alpha1.BucketAccess) field.ErrorList {
+	allErrs := ratchet(ValidateBucketAccess(new), ValidateBucketAccess(old))
+	specPath := field.NewPath("spec")
+
+	if old.Spec.BucketClaimName != new.Spec.BucketClaimName {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("bucketClaimName"), new.Spec.BucketClaimName, immutableFieldMsg))
+	}
+	if old.Spec.BucketClaimNamespace != new.Spec.BucketClaimNamespace {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("bucketClaimNamespace"), new.Spec.BucketClaimNamespace, immutableFieldMsg))
+	}
+	// credentials that have been minted cannot be narrowed or widened in place
+	if old.Spec.AccessMode != new.Spec.AccessMode {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("accessMode"), new.Spec.AccessMode, immutableFieldMsg))
+	}
+
+	return allErrs
+}
+
+// ValidateBucketClaimGrant validates a BucketClaimGrant on creation
+func ValidateBucketClaimGrant(grant *v1alpha1.BucketClaimGrant) field.ErrorList {
+	allErrs := field.ErrorList{}
+	specPath := field.NewPath("spec")
+
+	if len(grant.Spec.From) == 0 {
+		allErrs = append(allErrs, field.Required(specPath.Child("from"), ""))
+	}
+	for i, from := range grant.Spec.From {
+		allErrs = append(allErrs, validateNamespace(from.Namespace, specPath.Child("from").Index(i).Child("namespace"))...)
+	}
+	if len(grant.Spec.To) == 0 {
+		allErrs = append(allErrs, field.Required(specPath.Child("to"), ""))
+	}
+
+	return allErrs
+}
+
+// ValidateBucketClaimGrantUpdate validates an update of a BucketClaimGrant
+func ValidateBucketClaimGrantUpdate(old, new *v1alpha1.BucketClaimGrant) field.ErrorList {
+	return ratchet(ValidateBucketClaimGrant(new), ValidateBucketClaimGrant(old))
+}
+
+// ValidateBucketClass validates a BucketClass on creation
+func ValidateBucketClass(class *v1alpha1.BucketClass) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if class.DriverName == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("driverName"), ""))
+	}
+	allErrs = append(allErrs, validateDeletionPolicy(class.DeletionPolicy, field.NewPath("deletionPolicy"))...)
+	allErrs = append(allErrs, validateQuota(class.DefaultQuota, field.NewPath("defaultQuota"))...)
+	allErrs = append(allErrs, validateFeatures(class.Features, field.NewPath("features"))...)
+	allErrs = append(allErrs, ValidateLifecycleRules(class.LifecycleRules, versioningEnabled(class.Features), field.NewPath("lifecycleRules"))...)
+	allErrs = append(allErrs, validateEncryption(class.Encryption, field.NewPath("encryption"))...)
+
+	return allErrs
+}
+
+// ValidateBucketClassUpdate validates an update of a BucketClass
+func ValidateBucketClassUpdate(old, new *v1alpha1.BucketClass) field.ErrorList {
+	allErrs := ratchet(ValidateBucketClass(new), ValidateBucketClass(old))
+
+	if old.DriverName != new.DriverName {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("driverName"), new.DriverName, immutableFieldMsg))
+	}
+	allErrs = append(allErrs, validateFeaturesUpdate(old.Features, new.Features, field.NewPath("features"))...)
+	if old.Encryption != nil && new.Encryption == nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("encryption"), "cannot be removed once set"))
+	}
+
+	return allErrs
+}
+
+// ValidateBucketAccessClass validates a BucketAccessClass on creation
+func ValidateBucketAccessClass(class *v1alpha1.BucketAccessClass) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if class.DriverName == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("driverName"), ""))
+	}
+	if !contains(supportedAuthenticationTypes, string(class.AuthenticationType)) {
+		allErrs = append(allErrs, field.NotSupported(field.NewPath("authenticationType"), class.AuthenticationType, supportedAuthenticationTypes))
+	}
+	allErrs = append(allErrs, validateAccessMode(class.AccessMode, field.NewPath("accessMode"))...)
+	if class.RotationPolicy != nil {
+		allErrs = append(allErrs, validateRotationPolicy(class.RotationPolicy, field.NewPath("rotationPolicy"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateBucketAccessClassUpdate validates an update of a BucketAccessClass
+func ValidateBucketAccessClassUpdate(old, new *v1alpha1.BucketAccessClass) field.ErrorList {
+	allErrs := ratchet(ValidateBucketAccessClass(new), ValidateBucketAccessClass(old))
+
+	if old.DriverName != new.DriverName {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("driverName"), new.DriverName, immutableFieldMsg))
+	}
+
+	return allErrs
+}
+
+// ValidateLifecycleRules validates a list of lifecycle rules. Rules that expire
+// noncurrent versions are only valid if versioning is enabled. Drivers can use
+// it to check the rules they apply.
+func ValidateLifecycleRules(rules []v1alpha1.LifecycleRule, versioning bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	ids := map[string]bool{}
+	for i, rule := range rules {
+		rulePath := fldPath.Index(i)
+		if rule.ID == "" {
+			allErrs = append(allErrs, field.Required(rulePath.Child("id"), ""))
+		} else if ids[rule.ID] {
+			allErrs = append(allErrs, field.Duplicate(rulePath.Child("id"), rule.ID))
+		}
+		ids[rule.ID] = true
+
+		if rule.ExpirationDays == nil && rule.NoncurrentVersionExpirationDays == nil && len(rule.Transitions) == 0 {
+			allErrs = append(allErrs, field.Required(rulePath, "at least one of expirationDays, noncurrentVersionExpirationDays or transitions must be set"))
+		}
+		if days := rule.ExpirationDays; days != nil && *days <= 0 {
+			allErrs = append(allErrs, field.Invalid(rulePath.Child("expirationDays"), *days, "must be greater than zero"))
+		}
+		if days := rule.NoncurrentVersionExpirationDays; days != nil {
+			if *days <= 0 {
+				allErrs = append(allErrs, field.Invalid(rulePath.Child("noncurrentVersionExpirationDays"), *days, "must be greater than zero"))
+			}
+			if !versioning {
+				allErrs = append(allErrs, field.Invalid(rulePath.Child("noncurrentVersionExpirationDays"), *days, "requires versioning to be enabled"))
+			}
+		}
+
+		var previous int32
+		for j, t := range rule.Transitions {
+			transitionPath := rulePath.Child("transitions").Index(j)
+			if t.StorageClass == "" {
+				allErrs = append(allErrs, field.Required(transitionPath.Child("storageClass"), ""))
+			}
+			switch {
+			case t.Days <= 0:
+				allErrs = append(allErrs, field.Invalid(transitionPath.Child("days"), t.Days, "must be greater than zero"))
+			case t.Days <= previous:
+				allErrs = append(allErrs, field.Invalid(transitionPath.Child("days"), t.Days, "must be greater than the days of the previous transition"))
+			case rule.ExpirationDays != nil && t.Days >= *rule.ExpirationDays:
+				allErrs = append(allErrs, field.Invalid(transitionPath.Child("days"), t.Days, "must be less than expirationDays"))
+			}
+			previous = t.Days
+		}
+	}
+
+	return allErrs
+}
+
+func validateEncryption(encryption *v1alpha1.BucketEncryption, fldPath *field.Path) field.ErrorList {
+	if encryption == nil {
+		return nil
+	}
+
+	allErrs := field.ErrorList{}
+	kmsKeyPath := fldPath.Child("kmsKeyID")
+	secretRefPath := fldPath.Child("customerKeySecretRef")
+	switch encryption.Algorithm {
+	case v1alpha1.EncryptionAlgorithmProviderManaged:
+		if encryption.KMSKeyID != "" {
+			allErrs = append(allErrs, field.Forbidden(kmsKeyPath, "may only be set with the KMS algorithm"))
+		}
+		if encryption.CustomerKeySecretRef != nil {
+			allErrs = append(allErrs, field.Forbidden(secretRefPath, "may only be set with the CustomerKey algorithm"))
+		}
+	case v1alpha1.EncryptionAlgorithmKMS:
+		if encryption.KMSKeyID == "" {
+			allErrs = append(allErrs, field.Required(kmsKeyPath, "required with the KMS algorithm"))
+		}
+		if encryption.CustomerKeySecretRef != nil {
+			allErrs = append(allErrs, field.Forbidden(secretRefPath, "may only be set with the CustomerKey algorithm"))
+		}
+	case v1alpha1.EncryptionAlgorithmCustomerKey:
+		if encryption.KMSKeyID != "" {
+			allErrs = append(allErrs, field.Forbidden(kmsKeyPath, "may only be set with the KMS algorithm"))
+		}
+		if ref := encryption.CustomerKeySecretRef; ref == nil {
+			allErrs = append(allErrs, field.Required(secretRefPath, "required with the CustomerKey algorithm"))
+		} else {
+			if ref.Name == "" {
+				allErrs = append(allErrs, field.Required(secretRefPath.Child("name"), ""))
+			}
+			allErrs = append(allErrs, validateNamespace(ref.Namespace, secretRefPath.Child("namespace"))...)
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("algorithm"), encryption.Algorithm, supportedEncryptionAlgorithms))
+	}
+	return allErrs
+}
+
+// ratchet returns the errors of errs that old does not have. The create rules
+// of a field that an update leaves as it was report the same errors for the
+// old and the new object, and are thereby only applied to changed fields.
+func ratchet(errs, old field.ErrorList) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for _, err := range errs {
+		if !containsError(old, err) {
+			allErrs = append(allErrs, err)
+		}
+	}
+	return allErrs
+}
+
+func containsError(errs field.ErrorList, err *field.Error) bool {
+	for _, e := range errs {
+		if e.Type == err.Type && e.Field == err.Field && e.Detail == err.Detail && reflect.DeepEqual(e.BadValue, err.BadValue) {
+			return true
+		}
+	}
+	return false
+}
+
+func versioningEnabled(features *v1alpha1.BucketFeatures) bool {
+	return features != nil && features.Versioning
+}
+
+func validateProtocols(protocols []v1alpha1.Protocol, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(protocols) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "at least one protocol must be specified"))
+	}
+	for i, p := range protocols {
+		allErrs = append(allErrs, validateProtocol(p, fldPath.Index(i))...)
+	}
+
+	return allErrs
+}
+
+func validateProtocol(protocol v1alpha1.Protocol, fldPath *field.Path) field.ErrorList {
+	if !contains(supportedProtocols, string(protocol)) {
+		return field.ErrorList{field.NotSupported(fldPath, protocol, supportedProtocols)}
+	}
+	return nil
+}
+
+func validateRotationPolicy(policy *v1alpha1.CredentialRotationPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if policy.Interval.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("interval"), policy.Interval.Duration.String(), "must be greater than zero"))
+	}
+	if grace := policy.GracePeriod; grace != nil {
+		if grace.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("gracePeriod"), grace.Duration.String(), "must not be negative"))
+		} else if grace.Duration >= policy.Interval.Duration {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("gracePeriod"), grace.Duration.String(), "must be shorter than interval"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateQuota(quota *v1alpha1.BucketQuota, fldPath *field.Path) field.ErrorList {
+	if quota == nil {
+		return nil
+	}
+
+	allErrs := field.ErrorList{}
+	if quota.MaxSize != nil && quota.MaxSize.Sign() <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxSize"), quota.MaxSize.String(), "must be greater than zero"))
+	}
+	if quota.MaxObjects != nil && *quota.MaxObjects <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxObjects"), *quota.MaxObjects, "must be greater than zero"))
+	}
+	return allErrs
+}
+
+func validateFeatures(features *v1alpha1.BucketFeatures, fldPath *field.Path) field.ErrorList {
+	if features == nil || features.ObjectLock == nil {
+		return nil
+	}
+
+	allErrs := field.ErrorList{}
+	lock := features.ObjectLock
+	lockPath := fldPath.Child("objectLock")
+	if !features.Versioning {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("versioning"), features.Versioning, "must be enabled to use objectLock"))
+	}
+	if !contains(supportedObjectLockModes, string(lock.Mode)) {
+		allErrs = append(allErrs, field.NotSupported(lockPath.Child("mode"), lock.Mode, supportedObjectLockModes))
+	}
+	if lock.DefaultRetention != nil && lock.DefaultRetention.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(lockPath.Child("defaultRetention"), lock.DefaultRetention.Duration.String(), "must be greater than zero"))
+	}
+	return allErrs
+}
+
+// validateFeaturesUpdate rejects updates that weaken data protection: features
+// can be enabled and strengthened, but never disabled or relaxed
+func validateFeaturesUpdate(old, new *v1alpha1.BucketFeatures, fldPath *field.Path) field.ErrorList {
+	if old == nil {
+		return nil
+	}
+	allErrs := field.ErrorList{}
+	if new == nil {
+		return append(allErrs, field.Forbidden(fldPath, "cannot be removed once set"))
+	}
+
+	if old.Versioning && !new.Versioning {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("versioning"), "cannot be disabled once enabled"))
+	}
+
+	oldLock, newLock := old.ObjectLock, new.ObjectLock
+	lockPath := fldPath.Child("objectLock")
+	switch {
+	case oldLock == nil:
+	case newLock == nil:
+		allErrs = append(allErrs, field.Forbidden(lockPath, "cannot be removed once set"))
+	default:
+		if oldLock.Mode == v1alpha1.ObjectLockModeCompliance && newLock.Mode != v1alpha1.ObjectLockModeCompliance {
+			allErrs = append(allErrs, field.Forbidden(lockPath.Child("mode"), "cannot be relaxed from Compliance"))
+		}
+		if oldLock.DefaultRetention != nil {
+			if newLock.DefaultRetention == nil {
+				allErrs = append(allErrs, field.Forbidden(lockPath.Child("defaultRetention"), "cannot be removed once set"))
+			} else if newLock.DefaultRetention.Duration < oldLock.DefaultRetention.Duration {
+				allErrs = append(allErrs, field.Forbidden(lockPath.Child("defaultRetention"), "cannot be shortened"))
+			}
+		}
+	}
+	return allErrs
+}
+
+func validateNamespace(namespace string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if namespace == "" {
+		return append(allErrs, field.Required(fldPath, ""))
+	}
+	for _, msg := range validation.IsDNS1123Label(namespace) {
+		allErrs = append(allErrs, field.Invalid(fldPath, namespace, msg))
+	}
+	return allErrs
+}
+
+// validateDeletionPolicy allows an empty policy, which is defaulted to Retain
+func validateDeletionPolicy(policy v1alpha1.DeletionPolicy, fldPath *field.Path) field.ErrorList {
+	if policy != "" && !contains(supportedDeletionPolicies, string(policy)) {
+		return field.ErrorList{field.NotSupported(fldPath, policy, supportedDeletionPolicies)}
+	}
+	return nil
+}
+
+// validateAccessMode allows an empty mode, which is inherited from the class
+// or defaulted to ReadWrite
+func validateAccessMode(mode v1alpha1.AccessMode, fldPath *field.Path) field.ErrorList {
+	if mode != "" && !contains(supportedAccessModes, string(mode)) {
+		return field.ErrorList{field.NotSupported(fldPath, mode, supportedAccessModes)}
+	}
+	return nil
+}
+
+func containsProtocol(protocols []v1alpha1.Protocol, protocol v1alpha1.Protocol) bool {
+	for _, p := range protocols {
+		if p == protocol {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
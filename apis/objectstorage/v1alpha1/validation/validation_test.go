@@ -0,0 +1,471 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+)
+
+func validBucket() *v1alpha1.Bucket {
+	return &v1alpha1.Bucket{
+		ObjectMeta: metav1.ObjectMeta{Name: "bucket"},
+		Spec: v1alpha1.BucketSpec{
+			DriverName:      "driver",
+			BucketClassName: "class",
+			BucketClaim:     &corev1.ObjectReference{Name: "claim", Namespace: "ns"},
+			Protocols:       []v1alpha1.Protocol{v1alpha1.ProtocolS3},
+			DeletionPolicy:  v1alpha1.DeletionPolicyDelete,
+		},
+	}
+}
+
+func validBucketAccess() *v1alpha1.BucketAccess {
+	return &v1alpha1.BucketAccess{
+		ObjectMeta: metav1.ObjectMeta{Name: "access", Namespace: "ns"},
+		Spec: v1alpha1.BucketAccessSpec{
+			BucketClaimName:       "claim",
+			BucketAccessClassName: "class",
+			CredentialsSecretName: "creds",
+		},
+	}
+}
+
+func validBucketAccessClass() *v1alpha1.BucketAccessClass {
+	return &v1alpha1.BucketAccessClass{
+		ObjectMeta:         metav1.ObjectMeta{Name: "class"},
+		DriverName:         "driver",
+		AuthenticationType: v1alpha1.AuthenticationTypeKey,
+	}
+}
+
+// fields returns the paths of the fields that errs are reported for
+func fields(errs field.ErrorList) []string {
+	paths := []string{}
+	for _, err := range errs {
+		paths = append(paths, err.Field)
+	}
+	return paths
+}
+
+func checkFields(t *testing.T, errs field.ErrorList, want []string) {
+	t.Helper()
+	if want == nil {
+		want = []string{}
+	}
+	if got := fields(errs); !reflect.DeepEqual(got, want) {
+		t.Errorf("errors for %v, want %v: %v", got, want, errs)
+	}
+}
+
+func TestValidateBucket(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(*v1alpha1.Bucket)
+		want   []string
+	}{
+		{
+			name:   "valid",
+			mutate: func(b *v1alpha1.Bucket) {},
+		},
+		{
+			name:   "no driver",
+			mutate: func(b *v1alpha1.Bucket) { b.Spec.DriverName = "" },
+			want:   []string{"spec.driverName"},
+		},
+		{
+			name:   "no claim reference",
+			mutate: func(b *v1alpha1.Bucket) { b.Spec.BucketClaim = nil },
+			want:   []string{"spec.bucketClaim"},
+		},
+		{
+			name:   "unsupported protocol",
+			mutate: func(b *v1alpha1.Bucket) { b.Spec.Protocols = append(b.Spec.Protocols, "FTP") },
+			want:   []string{"spec.protocols[1]"},
+		},
+		{
+			name:   "unsupported deletion policy",
+			mutate: func(b *v1alpha1.Bucket) { b.Spec.DeletionPolicy = "Archive" },
+			want:   []string{"spec.deletionPolicy"},
+		},
+		{
+			name: "object lock without versioning",
+			mutate: func(b *v1alpha1.Bucket) {
+				b.Spec.Features = &v1alpha1.BucketFeatures{ObjectLock: &v1alpha1.ObjectLock{Mode: v1alpha1.ObjectLockModeGovernance}}
+			},
+			want: []string{"spec.features.versioning"},
+		},
+		{
+			name: "KMS encryption without key",
+			mutate: func(b *v1alpha1.Bucket) {
+				b.Spec.Encryption = &v1alpha1.BucketEncryption{Algorithm: v1alpha1.EncryptionAlgorithmKMS}
+			},
+			want: []string{"spec.encryption.kmsKeyID"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bucket := validBucket()
+			test.mutate(bucket)
+			checkFields(t, ValidateBucket(bucket), test.want)
+		})
+	}
+}
+
+func TestValidateBucketUpdate(t *testing.T) {
+	// legacy is a Bucket stored before protocols were validated
+	legacy := func() *v1alpha1.Bucket {
+		b := validBucket()
+		b.Spec.Protocols = []v1alpha1.Protocol{"FTP"}
+		return b
+	}
+
+	tests := []struct {
+		name   string
+		old    func() *v1alpha1.Bucket
+		mutate func(*v1alpha1.Bucket)
+		want   []string
+	}{
+		{
+			name:   "valid",
+			old:    validBucket,
+			mutate: func(b *v1alpha1.Bucket) { b.Spec.Parameters = map[string]string{"key": "value"} },
+		},
+		{
+			name:   "invalid field introduced",
+			old:    validBucket,
+			mutate: func(b *v1alpha1.Bucket) { b.Spec.DeletionPolicy = "Archive" },
+			want:   []string{"spec.deletionPolicy"},
+		},
+		{
+			name:   "immutable driver",
+			old:    validBucket,
+			mutate: func(b *v1alpha1.Bucket) { b.Spec.DriverName = "other" },
+			want:   []string{"spec.driverName"},
+		},
+		{
+			name:   "immutable claim reference",
+			old:    validBucket,
+			mutate: func(b *v1alpha1.Bucket) { b.Spec.BucketClaim.Name = "other" },
+			want:   []string{"spec.bucketClaim"},
+		},
+		{
+			name:   "claim uid filled in",
+			old:    validBucket,
+			mutate: func(b *v1alpha1.Bucket) { b.Spec.BucketClaim.UID = "uid" },
+		},
+		{
+			name: "versioning disabled",
+			old: func() *v1alpha1.Bucket {
+				b := validBucket()
+				b.Spec.Features = &v1alpha1.BucketFeatures{Versioning: true}
+				return b
+			},
+			mutate: func(b *v1alpha1.Bucket) { b.Spec.Features.Versioning = false },
+			want:   []string{"spec.features.versioning"},
+		},
+		{
+			name:   "legacy finalizer removed",
+			old:    legacy,
+			mutate: func(b *v1alpha1.Bucket) { b.Finalizers = nil },
+		},
+		{
+			name: "legacy status updated",
+			old:  legacy,
+			mutate: func(b *v1alpha1.Bucket) {
+				b.Status.BucketReady = true
+				b.Status.BucketID = "id"
+			},
+		},
+		{
+			name:   "legacy unrelated field changed",
+			old:    legacy,
+			mutate: func(b *v1alpha1.Bucket) { b.Spec.Parameters = map[string]string{"key": "value"} },
+		},
+		{
+			name:   "legacy field made invalid in another way",
+			old:    legacy,
+			mutate: func(b *v1alpha1.Bucket) { b.Spec.Protocols = []v1alpha1.Protocol{"SFTP"} },
+			want:   []string{"spec.protocols[0]"},
+		},
+		{
+			name:   "legacy field fixed",
+			old:    legacy,
+			mutate: func(b *v1alpha1.Bucket) { b.Spec.Protocols = []v1alpha1.Protocol{v1alpha1.ProtocolS3} },
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			old := test.old()
+			old.Finalizers = []string{"cosi.objectstorage.k8s.io/bucket-protection"}
+			new := old.DeepCopy()
+			test.mutate(new)
+			checkFields(t, ValidateBucketUpdate(old, new), test.want)
+		})
+	}
+}
+
+func TestValidateBucketClaim(t *testing.T) {
+	valid := &v1alpha1.BucketClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "claim", Namespace: "ns"},
+		Spec: v1alpha1.BucketClaimSpec{
+			BucketClassName: "class",
+			Protocols:       []v1alpha1.Protocol{v1alpha1.ProtocolS3},
+		},
+	}
+	checkFields(t, ValidateBucketClaim(valid), nil)
+
+	noProtocols := valid.DeepCopy()
+	noProtocols.Spec.Protocols = nil
+	checkFields(t, ValidateBucketClaim(noProtocols), []string{"spec.protocols"})
+
+	// a claim stored without protocols can still be bound
+	bound := noProtocols.DeepCopy()
+	bound.Status.BucketName = "bucket"
+	bound.Status.BucketReady = true
+	checkFields(t, ValidateBucketClaimUpdate(noProtocols, bound), nil)
+
+	otherClass := valid.DeepCopy()
+	otherClass.Spec.BucketClassName = "other"
+	checkFields(t, ValidateBucketClaimUpdate(valid, otherClass), []string{"spec.bucketClassName"})
+}
+
+func TestValidateBucketAccess(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(*v1alpha1.BucketAccess)
+		want   []string
+	}{
+		{
+			name:   "valid",
+			mutate: func(a *v1alpha1.BucketAccess) {},
+		},
+		{
+			name: "IAM without secret",
+			mutate: func(a *v1alpha1.BucketAccess) {
+				a.Spec.CredentialsSecretName = ""
+				a.Spec.ServiceAccountName = "sa"
+			},
+		},
+		{
+			name:   "no credentials",
+			mutate: func(a *v1alpha1.BucketAccess) { a.Spec.CredentialsSecretName = "" },
+			want:   []string{"spec.credentialsSecretName"},
+		},
+		{
+			name:   "invalid claim namespace",
+			mutate: func(a *v1alpha1.BucketAccess) { a.Spec.BucketClaimNamespace = "Not_A_Namespace" },
+			want:   []string{"spec.bucketClaimNamespace"},
+		},
+		{
+			name:   "unsupported access mode",
+			mutate: func(a *v1alpha1.BucketAccess) { a.Spec.AccessMode = "Admin" },
+			want:   []string{"spec.accessMode"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			access := validBucketAccess()
+			test.mutate(access)
+			checkFields(t, ValidateBucketAccess(access), test.want)
+		})
+	}
+}
+
+func TestValidateBucketAccessUpdate(t *testing.T) {
+	tests := []struct {
+		name   string
+		old    func(*v1alpha1.BucketAccess)
+		mutate func(*v1alpha1.BucketAccess)
+		want   []string
+	}{
+		{
+			name:   "granted",
+			old:    func(a *v1alpha1.BucketAccess) {},
+			mutate: func(a *v1alpha1.BucketAccess) { a.Status.AccessGranted = true },
+		},
+		{
+			name:   "access mode changed",
+			old:    func(a *v1alpha1.BucketAccess) {},
+			mutate: func(a *v1alpha1.BucketAccess) { a.Spec.AccessMode = v1alpha1.AccessModeReadOnly },
+			want:   []string{"spec.accessMode"},
+		},
+		{
+			name:   "legacy without credentials deleted",
+			old:    func(a *v1alpha1.BucketAccess) { a.Spec.CredentialsSecretName = "" },
+			mutate: func(a *v1alpha1.BucketAccess) { a.Finalizers = nil },
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			old := validBucketAccess()
+			old.Finalizers = []string{"cosi.objectstorage.k8s.io/bucketaccess-protection"}
+			test.old(old)
+			new := old.DeepCopy()
+			test.mutate(new)
+			checkFields(t, ValidateBucketAccessUpdate(old, new), test.want)
+		})
+	}
+}
+
+func TestValidateBucketClaimGrant(t *testing.T) {
+	valid := &v1alpha1.BucketClaimGrant{
+		ObjectMeta: metav1.ObjectMeta{Name: "grant", Namespace: "ns"},
+		Spec: v1alpha1.BucketClaimGrantSpec{
+			From: []v1alpha1.BucketClaimGrantFrom{{Namespace: "other"}},
+			To:   []v1alpha1.BucketClaimGrantTo{{Name: "claim"}},
+		},
+	}
+	checkFields(t, ValidateBucketClaimGrant(valid), nil)
+
+	invalid := valid.DeepCopy()
+	invalid.Spec.From[0].Namespace = "Other"
+	invalid.Spec.To = nil
+	checkFields(t, ValidateBucketClaimGrant(invalid), []string{"spec.from[0].namespace", "spec.to"})
+
+	relabeled := invalid.DeepCopy()
+	relabeled.Labels = map[string]string{"team": "a"}
+	checkFields(t, ValidateBucketClaimGrantUpdate(invalid, relabeled), nil)
+}
+
+func TestValidateBucketClass(t *testing.T) {
+	valid := &v1alpha1.BucketClass{
+		ObjectMeta:     metav1.ObjectMeta{Name: "class"},
+		DriverName:     "driver",
+		DeletionPolicy: v1alpha1.DeletionPolicyRetain,
+	}
+	checkFields(t, ValidateBucketClass(valid), nil)
+
+	invalid := valid.DeepCopy()
+	invalid.DriverName = ""
+	invalid.DefaultQuota = &v1alpha1.BucketQuota{MaxObjects: int64Ptr(0)}
+	checkFields(t, ValidateBucketClass(invalid), []string{"driverName", "defaultQuota.maxObjects"})
+
+	annotated := invalid.DeepCopy()
+	annotated.Annotations = map[string]string{v1alpha1.IsDefaultClassAnnotation: "true"}
+	checkFields(t, ValidateBucketClassUpdate(invalid, annotated), nil)
+
+	withEncryption := valid.DeepCopy()
+	withEncryption.Encryption = &v1alpha1.BucketEncryption{Algorithm: v1alpha1.EncryptionAlgorithmProviderManaged}
+	checkFields(t, ValidateBucketClassUpdate(withEncryption, valid), []string{"encryption"})
+}
+
+func TestValidateBucketAccessClass(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(*v1alpha1.BucketAccessClass)
+		want   []string
+	}{
+		{
+			name:   "valid",
+			mutate: func(c *v1alpha1.BucketAccessClass) {},
+		},
+		{
+			name:   "unsupported authentication type",
+			mutate: func(c *v1alpha1.BucketAccessClass) { c.AuthenticationType = "Token" },
+			want:   []string{"authenticationType"},
+		},
+		{
+			name: "grace period longer than interval",
+			mutate: func(c *v1alpha1.BucketAccessClass) {
+				c.RotationPolicy = &v1alpha1.CredentialRotationPolicy{
+					Interval:    metav1.Duration{Duration: time.Hour},
+					GracePeriod: &metav1.Duration{Duration: 2 * time.Hour},
+				}
+			},
+			want: []string{"rotationPolicy.gracePeriod"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			class := validBucketAccessClass()
+			test.mutate(class)
+			checkFields(t, ValidateBucketAccessClass(class), test.want)
+		})
+	}
+
+	legacy := validBucketAccessClass()
+	legacy.AuthenticationType = "Token"
+	relabeled := legacy.DeepCopy()
+	relabeled.Labels = map[string]string{"team": "a"}
+	checkFields(t, ValidateBucketAccessClassUpdate(legacy, relabeled), nil)
+
+	otherDriver := validBucketAccessClass()
+	otherDriver.DriverName = "other"
+	checkFields(t, ValidateBucketAccessClassUpdate(validBucketAccessClass(), otherDriver), []string{"driverName"})
+}
+
+func TestValidateLifecycleRules(t *testing.T) {
+	days := func(d int32) *int32 { return &d }
+	tests := []struct {
+		name       string
+		rules      []v1alpha1.LifecycleRule
+		versioning bool
+		want       []string
+	}{
+		{
+			name:  "valid",
+			rules: []v1alpha1.LifecycleRule{{ID: "expire", ExpirationDays: days(30)}},
+		},
+		{
+			name:  "duplicate id",
+			rules: []v1alpha1.LifecycleRule{{ID: "a", ExpirationDays: days(30)}, {ID: "a", ExpirationDays: days(60)}},
+			want:  []string{"rules[1].id"},
+		},
+		{
+			name:  "noncurrent versions without versioning",
+			rules: []v1alpha1.LifecycleRule{{ID: "a", NoncurrentVersionExpirationDays: days(30)}},
+			want:  []string{"rules[0].noncurrentVersionExpirationDays"},
+		},
+		{
+			name:       "noncurrent versions with versioning",
+			rules:      []v1alpha1.LifecycleRule{{ID: "a", NoncurrentVersionExpirationDays: days(30)}},
+			versioning: true,
+		},
+		{
+			name: "transition after expiration",
+			rules: []v1alpha1.LifecycleRule{{
+				ID:             "a",
+				ExpirationDays: days(30),
+				Transitions:    []v1alpha1.LifecycleTransition{{Days: 30, StorageClass: "GLACIER"}},
+			}},
+			want: []string{"rules[0].transitions[0].days"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			checkFields(t, ValidateLifecycleRules(test.rules, test.versioning, field.NewPath("rules")), test.want)
+		})
+	}
+}
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
@@ -22,7 +22,8 @@ limitations under the License.
 package v1alpha1
 
 import (
-	v1 "k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -32,7 +33,7 @@ func (in *Bucket) DeepCopyInto(out *Bucket) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -60,7 +61,7 @@ func (in *BucketAccess) DeepCopyInto(out *BucketAccess) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	out.Spec = in.Spec
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -94,6 +95,11 @@ func (in *BucketAccessClass) DeepCopyInto(out *BucketAccessClass) {
 			(*out)[key] = val
 		}
 	}
+	if in.RotationPolicy != nil {
+		in, out := &in.RotationPolicy, &out.RotationPolicy
+		*out = new(CredentialRotationPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -200,6 +206,21 @@ func (in *BucketAccessSpec) DeepCopy() *BucketAccessSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BucketAccessStatus) DeepCopyInto(out *BucketAccessStatus) {
 	*out = *in
+	if in.LastRotationTime != nil {
+		in, out := &in.LastRotationTime, &out.LastRotationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.PreviousCredentialsExpiryTime != nil {
+		in, out := &in.PreviousCredentialsExpiryTime, &out.PreviousCredentialsExpiryTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -219,7 +240,7 @@ func (in *BucketClaim) DeepCopyInto(out *BucketClaim) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -241,6 +262,124 @@ func (in *BucketClaim) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketClaimGrant) DeepCopyInto(out *BucketClaimGrant) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketClaimGrant.
+func (in *BucketClaimGrant) DeepCopy() *BucketClaimGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketClaimGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BucketClaimGrant) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketClaimGrantFrom) DeepCopyInto(out *BucketClaimGrantFrom) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketClaimGrantFrom.
+func (in *BucketClaimGrantFrom) DeepCopy() *BucketClaimGrantFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketClaimGrantFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketClaimGrantList) DeepCopyInto(out *BucketClaimGrantList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BucketClaimGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketClaimGrantList.
+func (in *BucketClaimGrantList) DeepCopy() *BucketClaimGrantList {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketClaimGrantList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BucketClaimGrantList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketClaimGrantSpec) DeepCopyInto(out *BucketClaimGrantSpec) {
+	*out = *in
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = make([]BucketClaimGrantFrom, len(*in))
+		copy(*out, *in)
+	}
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]BucketClaimGrantTo, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketClaimGrantSpec.
+func (in *BucketClaimGrantSpec) DeepCopy() *BucketClaimGrantSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketClaimGrantSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketClaimGrantTo) DeepCopyInto(out *BucketClaimGrantTo) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketClaimGrantTo.
+func (in *BucketClaimGrantTo) DeepCopy() *BucketClaimGrantTo {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketClaimGrantTo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BucketClaimList) DeepCopyInto(out *BucketClaimList) {
 	*out = *in
@@ -282,6 +421,11 @@ func (in *BucketClaimSpec) DeepCopyInto(out *BucketClaimSpec) {
 		*out = make([]Protocol, len(*in))
 		copy(*out, *in)
 	}
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		*out = new(BucketQuota)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -298,6 +442,13 @@ func (in *BucketClaimSpec) DeepCopy() *BucketClaimSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BucketClaimStatus) DeepCopyInto(out *BucketClaimStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -323,6 +474,28 @@ func (in *BucketClass) DeepCopyInto(out *BucketClass) {
 			(*out)[key] = val
 		}
 	}
+	if in.DefaultQuota != nil {
+		in, out := &in.DefaultQuota, &out.DefaultQuota
+		*out = new(BucketQuota)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Features != nil {
+		in, out := &in.Features, &out.Features
+		*out = new(BucketFeatures)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LifecycleRules != nil {
+		in, out := &in.LifecycleRules, &out.LifecycleRules
+		*out = make([]LifecycleRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(BucketEncryption)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -377,6 +550,48 @@ func (in *BucketClassList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketEncryption) DeepCopyInto(out *BucketEncryption) {
+	*out = *in
+	if in.CustomerKeySecretRef != nil {
+		in, out := &in.CustomerKeySecretRef, &out.CustomerKeySecretRef
+		*out = new(corev1.SecretReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketEncryption.
+func (in *BucketEncryption) DeepCopy() *BucketEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketFeatures) DeepCopyInto(out *BucketFeatures) {
+	*out = *in
+	if in.ObjectLock != nil {
+		in, out := &in.ObjectLock, &out.ObjectLock
+		*out = new(ObjectLock)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketFeatures.
+func (in *BucketFeatures) DeepCopy() *BucketFeatures {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketFeatures)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BucketList) DeepCopyInto(out *BucketList) {
 	*out = *in
@@ -410,12 +625,38 @@ func (in *BucketList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketQuota) DeepCopyInto(out *BucketQuota) {
+	*out = *in
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxObjects != nil {
+		in, out := &in.MaxObjects, &out.MaxObjects
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketQuota.
+func (in *BucketQuota) DeepCopy() *BucketQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BucketSpec) DeepCopyInto(out *BucketSpec) {
 	*out = *in
 	if in.BucketClaim != nil {
 		in, out := &in.BucketClaim, &out.BucketClaim
-		*out = new(v1.ObjectReference)
+		*out = new(corev1.ObjectReference)
 		**out = **in
 	}
 	if in.Protocols != nil {
@@ -430,6 +671,28 @@ func (in *BucketSpec) DeepCopyInto(out *BucketSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		*out = new(BucketQuota)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Features != nil {
+		in, out := &in.Features, &out.Features
+		*out = new(BucketFeatures)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LifecycleRules != nil {
+		in, out := &in.LifecycleRules, &out.LifecycleRules
+		*out = make([]LifecycleRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(BucketEncryption)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -446,6 +709,35 @@ func (in *BucketSpec) DeepCopy() *BucketSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BucketStatus) DeepCopyInto(out *BucketStatus) {
 	*out = *in
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = new(BucketUsage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AppliedFeatures != nil {
+		in, out := &in.AppliedFeatures, &out.AppliedFeatures
+		*out = new(BucketFeatures)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AppliedLifecycleRules != nil {
+		in, out := &in.AppliedLifecycleRules, &out.AppliedLifecycleRules
+		*out = make([]LifecycleRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AppliedEncryption != nil {
+		in, out := &in.AppliedEncryption, &out.AppliedEncryption
+		*out = new(BucketEncryption)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -458,3 +750,111 @@ func (in *BucketStatus) DeepCopy() *BucketStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketUsage) DeepCopyInto(out *BucketUsage) {
+	*out = *in
+	out.Size = in.Size.DeepCopy()
+	in.LastObservedTime.DeepCopyInto(&out.LastObservedTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketUsage.
+func (in *BucketUsage) DeepCopy() *BucketUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialRotationPolicy) DeepCopyInto(out *CredentialRotationPolicy) {
+	*out = *in
+	out.Interval = in.Interval
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialRotationPolicy.
+func (in *CredentialRotationPolicy) DeepCopy() *CredentialRotationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialRotationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecycleRule) DeepCopyInto(out *LifecycleRule) {
+	*out = *in
+	if in.ExpirationDays != nil {
+		in, out := &in.ExpirationDays, &out.ExpirationDays
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NoncurrentVersionExpirationDays != nil {
+		in, out := &in.NoncurrentVersionExpirationDays, &out.NoncurrentVersionExpirationDays
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Transitions != nil {
+		in, out := &in.Transitions, &out.Transitions
+		*out = make([]LifecycleTransition, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleRule.
+func (in *LifecycleRule) DeepCopy() *LifecycleRule {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecycleRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecycleTransition) DeepCopyInto(out *LifecycleTransition) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleTransition.
+func (in *LifecycleTransition) DeepCopy() *LifecycleTransition {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecycleTransition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectLock) DeepCopyInto(out *ObjectLock) {
+	*out = *in
+	if in.DefaultRetention != nil {
+		in, out := &in.DefaultRetention, &out.DefaultRetention
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectLock.
+func (in *ObjectLock) DeepCopy() *ObjectLock {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectLock)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+// Well-known condition types set in the status of COSI objects
+const (
+	// ConditionReady indicates that the object is ready for consumption.
+	// It is set on Buckets, BucketClaims and BucketAccesses.
+	ConditionReady = "Ready"
+
+	// ConditionBucketProvisioned indicates that the bucket exists in the OSP.
+	// It is set on Buckets.
+	ConditionBucketProvisioned = "BucketProvisioned"
+
+	// ConditionBucketBound indicates that a BucketClaim is bound to a Bucket.
+	// It is set on BucketClaims.
+	ConditionBucketBound = "BucketBound"
+
+	// ConditionAccessGranted indicates that the OSP has granted access to the
+	// bucket. It is set on BucketAccesses.
+	ConditionAccessGranted = "AccessGranted"
+)
+
+// Well-known reasons for the condition types above
+const (
+	// ReasonProvisioning means that a request to create the bucket is in
+	// progress.
+	ReasonProvisioning = "Provisioning"
+
+	// ReasonProvisioned means that the bucket was successfully created.
+	ReasonProvisioned = "Provisioned"
+
+	// ReasonBackendError means that the OSP returned an error.
+	ReasonBackendError = "BackendError"
+
+	// ReasonWaitingForBucketClass means that the referenced BucketClass does
+	// not exist yet.
+	ReasonWaitingForBucketClass = "WaitingForBucketClass"
+
+	// ReasonWaitingForBucketAccessClass means that the referenced
+	// BucketAccessClass does not exist yet.
+	ReasonWaitingForBucketAccessClass = "WaitingForBucketAccessClass"
+
+	// ReasonWaitingForBucket means that the Bucket backing a BucketClaim is not
+	// ready yet.
+	ReasonWaitingForBucket = "WaitingForBucket"
+
+	// ReasonWaitingForBucketClaim means that the BucketClaim referenced by a
+	// BucketAccess is not ready yet.
+	ReasonWaitingForBucketClaim = "WaitingForBucketClaim"
+
+	// ReasonBucketClaimNotGranted means that the BucketClaim referenced by a
+	// BucketAccess is in another namespace, and no BucketClaimGrant allows it.
+	ReasonBucketClaimNotGranted = "BucketClaimNotGranted"
+
+	// ReasonBound means that the BucketClaim was bound to its Bucket.
+	ReasonBound = "Bound"
+
+	// ReasonAccessGranted means that credentials were successfully minted.
+	ReasonAccessGranted = "AccessGranted"
+
+	// ReasonInvalidSpec means that the object cannot be reconciled as
+	// specified and will not be retried until its spec changes.
+	ReasonInvalidSpec = "InvalidSpec"
+
+	// ReasonDeleting means that the object is being removed from the OSP.
+	ReasonDeleting = "Deleting"
+)
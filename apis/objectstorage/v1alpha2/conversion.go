@@ -0,0 +1,587 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+)
+
+// The boolean readiness fields of v1alpha1 are represented by conditions in
+// v1alpha2: BucketReady by the Ready condition of Buckets and BucketClaims,
+// and AccessGranted by the AccessGranted condition of BucketAccesses.
+//
+// When a v1alpha1 object is ready but has no such condition, a condition with
+// ReasonConverted is added on conversion to v1alpha2. As v1alpha1 carries the
+// same information twice, the boolean may also disagree with its condition.
+// In both cases the boolean is kept in the V1alpha1StatusAnnotation of the
+// v1alpha2 object, and restored on conversion back unless the condition was
+// changed in between. Conversion is therefore lossless in both directions.
+
+// ReasonConverted is the reason of conditions added on conversion from the
+// boolean readiness fields of v1alpha1
+const ReasonConverted = "ConvertedFromV1alpha1"
+
+// V1alpha1StatusAnnotation holds the boolean readiness field of the v1alpha1
+// object that a v1alpha2 object was converted from, if it cannot be recovered
+// from the conditions alone
+const V1alpha1StatusAnnotation = "objectstorage.k8s.io/v1alpha1-status"
+
+// v1alpha1Status is the value of V1alpha1StatusAnnotation
+type v1alpha1Status struct {
+	// Value is the boolean field of the v1alpha1 object
+	Value bool `json:"value"`
+	// Condition is whether the condition was true after conversion
+	Condition bool `json:"condition"`
+	// Added is set if the condition was added on conversion
+	Added bool `json:"added,omitempty"`
+}
+
+// ConvertTo converts this Bucket to the hub version
+func (src *Bucket) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*v1alpha1.Bucket)
+	if !ok {
+		return unexpectedHub(dstRaw)
+	}
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = v1alpha1.BucketSpec{
+		DriverName:       src.Spec.DriverName,
+		BucketClassName:  src.Spec.BucketClassName,
+		BucketClaim:      src.Spec.BucketClaim,
+		Protocols:        protocolsToV1alpha1(src.Spec.Protocols),
+		Parameters:       src.Spec.Parameters,
+		DeletionPolicy:   v1alpha1.DeletionPolicy(src.Spec.DeletionPolicy),
+		ExistingBucketID: src.Spec.ExistingBucketID,
+		Quota:            quotaToV1alpha1(src.Spec.Quota),
+		Features:         featuresToV1alpha1(src.Spec.Features),
+		LifecycleRules:   lifecycleRulesToV1alpha1(src.Spec.LifecycleRules),
+		Encryption:       encryptionToV1alpha1(src.Spec.Encryption),
+	}
+	dst.Status = v1alpha1.BucketStatus{
+		BucketID:              src.Status.BucketID,
+		Usage:                 usageToV1alpha1(src.Status.Usage),
+		AppliedFeatures:       featuresToV1alpha1(src.Status.AppliedFeatures),
+		AppliedLifecycleRules: lifecycleRulesToV1alpha1(src.Status.AppliedLifecycleRules),
+		AppliedEncryption:     encryptionToV1alpha1(src.Status.AppliedEncryption),
+		ObservedGeneration:    src.Status.ObservedGeneration,
+	}
+	var err error
+	dst.Status.BucketReady, dst.Status.Conditions, err = conditionToBool(&dst.ObjectMeta, src.Status.Conditions, ConditionReady, "status.bucketReady")
+	return err
+}
+
+// ConvertFrom converts the hub version to this Bucket
+func (dst *Bucket) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*v1alpha1.Bucket)
+	if !ok {
+		return unexpectedHub(srcRaw)
+	}
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = BucketSpec{
+		DriverName:       src.Spec.DriverName,
+		BucketClassName:  src.Spec.BucketClassName,
+		BucketClaim:      src.Spec.BucketClaim,
+		Protocols:        protocolsFromV1alpha1(src.Spec.Protocols),
+		Parameters:       src.Spec.Parameters,
+		DeletionPolicy:   DeletionPolicy(src.Spec.DeletionPolicy),
+		ExistingBucketID: src.Spec.ExistingBucketID,
+		Quota:            quotaFromV1alpha1(src.Spec.Quota),
+		Features:         featuresFromV1alpha1(src.Spec.Features),
+		LifecycleRules:   lifecycleRulesFromV1alpha1(src.Spec.LifecycleRules),
+		Encryption:       encryptionFromV1alpha1(src.Spec.Encryption),
+	}
+	dst.Status = BucketStatus{
+		BucketID:              src.Status.BucketID,
+		Usage:                 usageFromV1alpha1(src.Status.Usage),
+		AppliedFeatures:       featuresFromV1alpha1(src.Status.AppliedFeatures),
+		AppliedLifecycleRules: lifecycleRulesFromV1alpha1(src.Status.AppliedLifecycleRules),
+		AppliedEncryption:     encryptionFromV1alpha1(src.Status.AppliedEncryption),
+		ObservedGeneration:    src.Status.ObservedGeneration,
+	}
+	var err error
+	dst.Status.Conditions, err = boolToCondition(&dst.ObjectMeta, src.Status.BucketReady, src.Status.Conditions, ConditionReady, "status.bucketReady")
+	return err
+}
+
+// ConvertTo converts this BucketClaim to the hub version
+func (src *BucketClaim) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*v1alpha1.BucketClaim)
+	if !ok {
+		return unexpectedHub(dstRaw)
+	}
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = v1alpha1.BucketClaimSpec{
+		BucketClassName:    src.Spec.BucketClassName,
+		Protocols:          protocolsToV1alpha1(src.Spec.Protocols),
+		ExistingBucketName: src.Spec.ExistingBucketName,
+		Quota:              quotaToV1alpha1(src.Spec.Quota),
+	}
+	dst.Status = v1alpha1.BucketClaimStatus{
+		BucketName:         src.Status.BucketName,
+		ObservedGeneration: src.Status.ObservedGeneration,
+	}
+	var err error
+	dst.Status.BucketReady, dst.Status.Conditions, err = conditionToBool(&dst.ObjectMeta, src.Status.Conditions, ConditionReady, "status.bucketReady")
+	return err
+}
+
+// ConvertFrom converts the hub version to this BucketClaim
+func (dst *BucketClaim) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*v1alpha1.BucketClaim)
+	if !ok {
+		return unexpectedHub(srcRaw)
+	}
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = BucketClaimSpec{
+		BucketClassName:    src.Spec.BucketClassName,
+		Protocols:          protocolsFromV1alpha1(src.Spec.Protocols),
+		ExistingBucketName: src.Spec.ExistingBucketName,
+		Quota:              quotaFromV1alpha1(src.Spec.Quota),
+	}
+	dst.Status = BucketClaimStatus{
+		BucketName:         src.Status.BucketName,
+		ObservedGeneration: src.Status.ObservedGeneration,
+	}
+	var err error
+	dst.Status.Conditions, err = boolToCondition(&dst.ObjectMeta, src.Status.BucketReady, src.Status.Conditions, ConditionReady, "status.bucketReady")
+	return err
+}
+
+// ConvertTo converts this BucketClaimGrant to the hub version
+func (src *BucketClaimGrant) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*v1alpha1.BucketClaimGrant)
+	if !ok {
+		return unexpectedHub(dstRaw)
+	}
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = v1alpha1.BucketClaimGrantSpec{}
+	for _, from := range src.Spec.From {
+		dst.Spec.From = append(dst.Spec.From, v1alpha1.BucketClaimGrantFrom{Namespace: from.Namespace})
+	}
+	for _, to := range src.Spec.To {
+		dst.Spec.To = append(dst.Spec.To, v1alpha1.BucketClaimGrantTo{Name: to.Name})
+	}
+	return nil
+}
+
+// ConvertFrom converts the hub version to this BucketClaimGrant
+func (dst *BucketClaimGrant) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*v1alpha1.BucketClaimGrant)
+	if !ok {
+		return unexpectedHub(srcRaw)
+	}
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = BucketClaimGrantSpec{}
+	for _, from := range src.Spec.From {
+		dst.Spec.From = append(dst.Spec.From, BucketClaimGrantFrom{Namespace: from.Namespace})
+	}
+	for _, to := range src.Spec.To {
+		dst.Spec.To = append(dst.Spec.To, BucketClaimGrantTo{Name: to.Name})
+	}
+	return nil
+}
+
+// ConvertTo converts this BucketClass to the hub version
+func (src *BucketClass) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*v1alpha1.BucketClass)
+	if !ok {
+		return unexpectedHub(dstRaw)
+	}
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.DriverName = src.Spec.DriverName
+	dst.DeletionPolicy = v1alpha1.DeletionPolicy(src.Spec.DeletionPolicy)
+	dst.Parameters = src.Spec.Parameters
+	dst.DefaultQuota = quotaToV1alpha1(src.Spec.DefaultQuota)
+	dst.Features = featuresToV1alpha1(src.Spec.Features)
+	dst.LifecycleRules = lifecycleRulesToV1alpha1(src.Spec.LifecycleRules)
+	dst.Encryption = encryptionToV1alpha1(src.Spec.Encryption)
+	return nil
+}
+
+// ConvertFrom converts the hub version to this BucketClass
+func (dst *BucketClass) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*v1alpha1.BucketClass)
+	if !ok {
+		return unexpectedHub(srcRaw)
+	}
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = BucketClassSpec{
+		DriverName:     src.DriverName,
+		DeletionPolicy: DeletionPolicy(src.DeletionPolicy),
+		Parameters:     src.Parameters,
+		DefaultQuota:   quotaFromV1alpha1(src.DefaultQuota),
+		Features:       featuresFromV1alpha1(src.Features),
+		LifecycleRules: lifecycleRulesFromV1alpha1(src.LifecycleRules),
+		Encryption:     encryptionFromV1alpha1(src.Encryption),
+	}
+	return nil
+}
+
+// ConvertTo converts this BucketAccessClass to the hub version
+func (src *BucketAccessClass) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*v1alpha1.BucketAccessClass)
+	if !ok {
+		return unexpectedHub(dstRaw)
+	}
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.DriverName = src.Spec.DriverName
+	dst.AuthenticationType = v1alpha1.AuthenticationType(src.Spec.AuthenticationType)
+	dst.Parameters = src.Spec.Parameters
+	dst.AccessMode = v1alpha1.AccessMode(src.Spec.AccessMode)
+	dst.RotationPolicy = nil
+	if policy := src.Spec.RotationPolicy; policy != nil {
+		dst.RotationPolicy = &v1alpha1.CredentialRotationPolicy{
+			Interval:    policy.Interval,
+			GracePeriod: policy.GracePeriod,
+		}
+	}
+	return nil
+}
+
+// ConvertFrom converts the hub version to this BucketAccessClass
+func (dst *BucketAccessClass) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*v1alpha1.BucketAccessClass)
+	if !ok {
+		return unexpectedHub(srcRaw)
+	}
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = BucketAccessClassSpec{
+		DriverName:         src.DriverName,
+		AuthenticationType: AuthenticationType(src.AuthenticationType),
+		Parameters:         src.Parameters,
+		AccessMode:         AccessMode(src.AccessMode),
+	}
+	if policy := src.RotationPolicy; policy != nil {
+		dst.Spec.RotationPolicy = &CredentialRotationPolicy{
+			Interval:    policy.Interval,
+			GracePeriod: policy.GracePeriod,
+		}
+	}
+	return nil
+}
+
+// ConvertTo converts this BucketAccess to the hub version
+func (src *BucketAccess) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*v1alpha1.BucketAccess)
+	if !ok {
+		return unexpectedHub(dstRaw)
+	}
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = v1alpha1.BucketAccessSpec{
+		BucketClaimName:       src.Spec.BucketClaimName,
+		BucketClaimNamespace:  src.Spec.BucketClaimNamespace,
+		Protocol:              v1alpha1.Protocol(src.Spec.Protocol),
+		BucketAccessClassName: src.Spec.BucketAccessClassName,
+		AccessMode:            v1alpha1.AccessMode(src.Spec.AccessMode),
+		CredentialsSecretName: src.Spec.CredentialsSecretName,
+		ServiceAccountName:    src.Spec.ServiceAccountName,
+	}
+	dst.Status = v1alpha1.BucketAccessStatus{
+		AccountID:                     src.Status.AccountID,
+		CredentialGeneration:          src.Status.CredentialGeneration,
+		LastRotationTime:              src.Status.LastRotationTime,
+		PreviousCredentialsExpiryTime: src.Status.PreviousCredentialsExpiryTime,
+		ObservedGeneration:            src.Status.ObservedGeneration,
+	}
+	var err error
+	dst.Status.AccessGranted, dst.Status.Conditions, err = conditionToBool(&dst.ObjectMeta, src.Status.Conditions, ConditionAccessGranted, "status.accessGranted")
+	return err
+}
+
+// ConvertFrom converts the hub version to this BucketAccess
+func (dst *BucketAccess) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*v1alpha1.BucketAccess)
+	if !ok {
+		return unexpectedHub(srcRaw)
+	}
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = BucketAccessSpec{
+		BucketClaimName:       src.Spec.BucketClaimName,
+		BucketClaimNamespace:  src.Spec.BucketClaimNamespace,
+		Protocol:              Protocol(src.Spec.Protocol),
+		BucketAccessClassName: src.Spec.BucketAccessClassName,
+		AccessMode:            AccessMode(src.Spec.AccessMode),
+		CredentialsSecretName: src.Spec.CredentialsSecretName,
+		ServiceAccountName:    src.Spec.ServiceAccountName,
+	}
+	dst.Status = BucketAccessStatus{
+		AccountID:                     src.Status.AccountID,
+		CredentialGeneration:          src.Status.CredentialGeneration,
+		LastRotationTime:              src.Status.LastRotationTime,
+		PreviousCredentialsExpiryTime: src.Status.PreviousCredentialsExpiryTime,
+		ObservedGeneration:            src.Status.ObservedGeneration,
+	}
+	var err error
+	dst.Status.Conditions, err = boolToCondition(&dst.ObjectMeta, src.Status.AccessGranted, src.Status.Conditions, ConditionAccessGranted, "status.accessGranted")
+	return err
+}
+
+// boolToCondition returns conditions with a condition of conditionType added
+// if value is true and there is no such condition yet. The value is kept in
+// the V1alpha1StatusAnnotation of obj if the condition was added or disagrees
+// with it.
+func boolToCondition(obj *metav1.ObjectMeta, value bool, conditions []metav1.Condition, conditionType, field string) ([]metav1.Condition, error) {
+	out := append([]metav1.Condition(nil), conditions...)
+	status := v1alpha1Status{Value: value}
+	if value && meta.FindStatusCondition(out, conditionType) == nil {
+		out = append(out, convertedCondition(conditionType, field, obj.CreationTimestamp))
+		status.Added = true
+	}
+	status.Condition = meta.IsStatusConditionTrue(out, conditionType)
+
+	if status.Added || status.Value != status.Condition {
+		raw, err := json.Marshal(status)
+		if err != nil {
+			return nil, err
+		}
+		setAnnotation(obj, V1alpha1StatusAnnotation, string(raw))
+	}
+	return out, nil
+}
+
+// conditionToBool returns whether the condition of conditionType is true, and
+// the conditions. If obj has the V1alpha1StatusAnnotation, the annotation is
+// removed, the value it holds is returned unless the condition changed since
+// conversion, and a condition added on conversion is removed unless it changed.
+func conditionToBool(obj *metav1.ObjectMeta, conditions []metav1.Condition, conditionType, field string) (bool, []metav1.Condition, error) {
+	value := meta.IsStatusConditionTrue(conditions, conditionType)
+	raw, ok := obj.Annotations[V1alpha1StatusAnnotation]
+	if !ok {
+		return value, append([]metav1.Condition(nil), conditions...), nil
+	}
+	removeAnnotation(obj, V1alpha1StatusAnnotation)
+
+	status := v1alpha1Status{}
+	if err := json.Unmarshal([]byte(raw), &status); err != nil {
+		return false, nil, fmt.Errorf("invalid %s annotation: %w", V1alpha1StatusAnnotation, err)
+	}
+
+	converted := convertedCondition(conditionType, field, obj.CreationTimestamp)
+	var out []metav1.Condition
+	for _, c := range conditions {
+		if status.Added && c.Type == converted.Type && c.Status == converted.Status && c.Reason == converted.Reason &&
+			c.Message == converted.Message && c.ObservedGeneration == converted.ObservedGeneration &&
+			c.LastTransitionTime.Equal(&converted.LastTransitionTime) {
+			continue
+		}
+		out = append(out, c)
+	}
+	if value == status.Condition {
+		value = status.Value
+	}
+	return value, out, nil
+}
+
+// setAnnotation sets an annotation on a copy of the annotations of obj, as
+// they are shared with the object converted from
+func setAnnotation(obj *metav1.ObjectMeta, key, value string) {
+	annotations := make(map[string]string, len(obj.Annotations)+1)
+	for k, v := range obj.Annotations {
+		annotations[k] = v
+	}
+	annotations[key] = value
+	obj.Annotations = annotations
+}
+
+// removeAnnotation removes an annotation from a copy of the annotations of obj
+func removeAnnotation(obj *metav1.ObjectMeta, key string) {
+	var annotations map[string]string
+	for k, v := range obj.Annotations {
+		if k == key {
+			continue
+		}
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[k] = v
+	}
+	obj.Annotations = annotations
+}
+
+// convertedCondition returns the condition of conditionType that represents a
+// true boolean field of v1alpha1
+func convertedCondition(conditionType, field string, since metav1.Time) metav1.Condition {
+	return metav1.Condition{
+		Type:               conditionType,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonConverted,
+		Message:            fmt.Sprintf("converted from %s of v1alpha1", field),
+		LastTransitionTime: since,
+	}
+}
+
+func quotaToV1alpha1(quota *BucketQuota) *v1alpha1.BucketQuota {
+	if quota == nil {
+		return nil
+	}
+	return &v1alpha1.BucketQuota{MaxSize: quota.MaxSize, MaxObjects: quota.MaxObjects}
+}
+
+func quotaFromV1alpha1(quota *v1alpha1.BucketQuota) *BucketQuota {
+	if quota == nil {
+		return nil
+	}
+	return &BucketQuota{MaxSize: quota.MaxSize, MaxObjects: quota.MaxObjects}
+}
+
+func featuresToV1alpha1(features *BucketFeatures) *v1alpha1.BucketFeatures {
+	if features == nil {
+		return nil
+	}
+	out := &v1alpha1.BucketFeatures{Versioning: features.Versioning}
+	if lock := features.ObjectLock; lock != nil {
+		out.ObjectLock = &v1alpha1.ObjectLock{
+			Mode:             v1alpha1.ObjectLockMode(lock.Mode),
+			DefaultRetention: lock.DefaultRetention,
+		}
+	}
+	return out
+}
+
+func featuresFromV1alpha1(features *v1alpha1.BucketFeatures) *BucketFeatures {
+	if features == nil {
+		return nil
+	}
+	out := &BucketFeatures{Versioning: features.Versioning}
+	if lock := features.ObjectLock; lock != nil {
+		out.ObjectLock = &ObjectLock{
+			Mode:             ObjectLockMode(lock.Mode),
+			DefaultRetention: lock.DefaultRetention,
+		}
+	}
+	return out
+}
+
+func lifecycleRulesToV1alpha1(rules []LifecycleRule) []v1alpha1.LifecycleRule {
+	if rules == nil {
+		return nil
+	}
+	out := make([]v1alpha1.LifecycleRule, 0, len(rules))
+	for _, rule := range rules {
+		r := v1alpha1.LifecycleRule{
+			ID:                              rule.ID,
+			Prefix:                          rule.Prefix,
+			ExpirationDays:                  rule.ExpirationDays,
+			NoncurrentVersionExpirationDays: rule.NoncurrentVersionExpirationDays,
+		}
+		for _, t := range rule.Transitions {
+			r.Transitions = append(r.Transitions, v1alpha1.LifecycleTransition{Days: t.Days, StorageClass: t.StorageClass})
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+func lifecycleRulesFromV1alpha1(rules []v1alpha1.LifecycleRule) []LifecycleRule {
+	if rules == nil {
+		return nil
+	}
+	out := make([]LifecycleRule, 0, len(rules))
+	for _, rule := range rules {
+		r := LifecycleRule{
+			ID:                              rule.ID,
+			Prefix:                          rule.Prefix,
+			ExpirationDays:                  rule.ExpirationDays,
+			NoncurrentVersionExpirationDays: rule.NoncurrentVersionExpirationDays,
+		}
+		for _, t := range rule.Transitions {
+			r.Transitions = append(r.Transitions, LifecycleTransition{Days: t.Days, StorageClass: t.StorageClass})
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+func encryptionToV1alpha1(encryption *BucketEncryption) *v1alpha1.BucketEncryption {
+	if encryption == nil {
+		return nil
+	}
+	return &v1alpha1.BucketEncryption{
+		Algorithm:            v1alpha1.EncryptionAlgorithm(encryption.Algorithm),
+		KMSKeyID:             encryption.KMSKeyID,
+		CustomerKeySecretRef: encryption.CustomerKeySecretRef,
+	}
+}
+
+func encryptionFromV1alpha1(encryption *v1alpha1.BucketEncryption) *BucketEncryption {
+	if encryption == nil {
+		return nil
+	}
+	return &BucketEncryption{
+		Algorithm:            EncryptionAlgorithm(encryption.Algorithm),
+		KMSKeyID:             encryption.KMSKeyID,
+		CustomerKeySecretRef: encryption.CustomerKeySecretRef,
+	}
+}
+
+func usageToV1alpha1(usage *BucketUsage) *v1alpha1.BucketUsage {
+	if usage == nil {
+		return nil
+	}
+	return &v1alpha1.BucketUsage{Size: usage.Size, ObjectCount: usage.ObjectCount, LastObservedTime: usage.LastObservedTime}
+}
+
+func usageFromV1alpha1(usage *v1alpha1.BucketUsage) *BucketUsage {
+	if usage == nil {
+		return nil
+	}
+	return &BucketUsage{Size: usage.Size, ObjectCount: usage.ObjectCount, LastObservedTime: usage.LastObservedTime}
+}
+
+func protocolsToV1alpha1(protocols []Protocol) []v1alpha1.Protocol {
+	if protocols == nil {
+		return nil
+	}
+	out := make([]v1alpha1.Protocol, 0, len(protocols))
+	for _, p := range protocols {
+		out = append(out, v1alpha1.Protocol(p))
+	}
+	return out
+}
+
+func protocolsFromV1alpha1(protocols []v1alpha1.Protocol) []Protocol {
+	if protocols == nil {
+		return nil
+	}
+	out := make([]Protocol, 0, len(protocols))
+	for _, p := range protocols {
+		out = append(out, Protocol(p))
+	}
+	return out
+}
+
+func unexpectedHub(hub conversion.Hub) error {
+	return fmt.Errorf("unexpected hub type %T", hub)
+}
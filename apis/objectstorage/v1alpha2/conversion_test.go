@@ -0,0 +1,412 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+)
+
+var (
+	created = metav1.NewTime(time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC))
+	later   = metav1.NewTime(time.Date(2022, 6, 1, 12, 5, 0, 0, time.UTC))
+)
+
+func condition(conditionType string, status metav1.ConditionStatus, reason, message string, since metav1.Time) metav1.Condition {
+	return metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: since,
+	}
+}
+
+func TestBucketRoundTrip(t *testing.T) {
+	converted := convertedCondition(ConditionReady, "status.bucketReady", created)
+	edited := converted
+	edited.Message = "set by hand"
+	stale := converted
+	stale.Status = metav1.ConditionFalse
+	provisioned := condition(ConditionReady, metav1.ConditionTrue, "Provisioned", "bucket is ready", later)
+
+	tests := []struct {
+		name       string
+		conditions []metav1.Condition
+		ready      bool
+	}{
+		{name: "no conditions"},
+		{name: "converted condition", conditions: []metav1.Condition{converted}, ready: true},
+		{name: "converted condition edited", conditions: []metav1.Condition{edited}, ready: true},
+		{name: "converted condition no longer true", conditions: []metav1.Condition{stale}},
+		{name: "condition set by the controller", conditions: []metav1.Condition{provisioned}, ready: true},
+		{
+			name: "other conditions",
+			conditions: []metav1.Condition{
+				condition("QuotaApplied", metav1.ConditionTrue, "Applied", "", later),
+				converted,
+			},
+			ready: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			in := fullBucket()
+			in.Status.Conditions = test.conditions
+
+			hub := &v1alpha1.Bucket{}
+			if err := in.DeepCopy().ConvertTo(hub); err != nil {
+				t.Fatal(err)
+			}
+			if hub.Status.BucketReady != test.ready {
+				t.Errorf("bucketReady is %v, want %v", hub.Status.BucketReady, test.ready)
+			}
+
+			out := &Bucket{}
+			if err := out.ConvertFrom(hub); err != nil {
+				t.Fatal(err)
+			}
+			if !apiequality.Semantic.DeepEqual(in, out) {
+				t.Errorf("round-trip changed the bucket:\n%+v\n%+v", in, out)
+			}
+		})
+	}
+}
+
+func TestBucketAccessRoundTrip(t *testing.T) {
+	converted := convertedCondition(ConditionAccessGranted, "status.accessGranted", created)
+	edited := converted
+	edited.LastTransitionTime = later
+	denied := condition(ConditionAccessGranted, metav1.ConditionFalse, "Denied", "policy rejected", later)
+
+	tests := []struct {
+		name       string
+		conditions []metav1.Condition
+		granted    bool
+	}{
+		{name: "no conditions"},
+		{name: "converted condition", conditions: []metav1.Condition{converted}, granted: true},
+		{name: "converted condition edited", conditions: []metav1.Condition{edited}, granted: true},
+		{name: "access denied", conditions: []metav1.Condition{denied}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			in := fullBucketAccess()
+			in.Status.Conditions = test.conditions
+
+			hub := &v1alpha1.BucketAccess{}
+			if err := in.DeepCopy().ConvertTo(hub); err != nil {
+				t.Fatal(err)
+			}
+			if hub.Status.AccessGranted != test.granted {
+				t.Errorf("accessGranted is %v, want %v", hub.Status.AccessGranted, test.granted)
+			}
+
+			out := &BucketAccess{}
+			if err := out.ConvertFrom(hub); err != nil {
+				t.Fatal(err)
+			}
+			if !apiequality.Semantic.DeepEqual(in, out) {
+				t.Errorf("round-trip changed the bucket access:\n%+v\n%+v", in, out)
+			}
+		})
+	}
+}
+
+func TestBucketClaimHubRoundTrip(t *testing.T) {
+	ready := condition(ConditionReady, metav1.ConditionTrue, "Bound", "", later)
+	notReady := condition(ConditionReady, metav1.ConditionFalse, "Provisioning", "", later)
+	converted := convertedCondition(ConditionReady, "status.bucketReady", created)
+
+	tests := []struct {
+		name       string
+		ready      bool
+		conditions []metav1.Condition
+		// annotated is whether the v1alpha2 object carries V1alpha1StatusAnnotation
+		annotated bool
+	}{
+		{name: "not ready"},
+		{name: "ready without condition", ready: true, annotated: true},
+		{name: "ready with condition", ready: true, conditions: []metav1.Condition{ready}},
+		{name: "not ready with condition", conditions: []metav1.Condition{notReady}},
+		{name: "ready with false condition", ready: true, conditions: []metav1.Condition{notReady}, annotated: true},
+		{name: "not ready with true condition", conditions: []metav1.Condition{ready}, annotated: true},
+		{name: "ready with converted condition", ready: true, conditions: []metav1.Condition{converted}},
+		{name: "not ready with converted condition", conditions: []metav1.Condition{converted}, annotated: true},
+		{
+			name:       "ready without condition and other conditions",
+			ready:      true,
+			conditions: []metav1.Condition{condition("QuotaApplied", metav1.ConditionTrue, "Applied", "", later)},
+			annotated:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			in := &v1alpha1.BucketClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: "claim", Namespace: "ns", CreationTimestamp: created},
+				Spec: v1alpha1.BucketClaimSpec{
+					BucketClassName: "class",
+					Protocols:       []v1alpha1.Protocol{v1alpha1.ProtocolS3},
+					Quota:           &v1alpha1.BucketQuota{MaxObjects: int64Ptr(1000)},
+				},
+				Status: v1alpha1.BucketClaimStatus{
+					BucketName:  "bucket",
+					BucketReady: test.ready,
+					Conditions:  test.conditions,
+				},
+			}
+
+			spoke := &BucketClaim{}
+			if err := spoke.ConvertFrom(in.DeepCopy()); err != nil {
+				t.Fatal(err)
+			}
+			if _, ok := spoke.Annotations[V1alpha1StatusAnnotation]; ok != test.annotated {
+				t.Errorf("annotated is %v, want %v", ok, test.annotated)
+			}
+			// an existing condition wins over the boolean in v1alpha2
+			want := test.ready
+			if meta.FindStatusCondition(test.conditions, ConditionReady) != nil {
+				want = meta.IsStatusConditionTrue(test.conditions, ConditionReady)
+			}
+			if got := meta.IsStatusConditionTrue(spoke.Status.Conditions, ConditionReady); got != want {
+				t.Errorf("ready condition is %v, want %v", got, want)
+			}
+
+			out := &v1alpha1.BucketClaim{}
+			if err := spoke.ConvertTo(out); err != nil {
+				t.Fatal(err)
+			}
+			if !apiequality.Semantic.DeepEqual(in, out) {
+				t.Errorf("round-trip changed the bucket claim:\n%+v\n%+v", in, out)
+			}
+		})
+	}
+}
+
+func TestBucketAccessHubRoundTrip(t *testing.T) {
+	denied := condition(ConditionAccessGranted, metav1.ConditionFalse, "Denied", "", later)
+
+	for _, granted := range []bool{false, true} {
+		for _, conditions := range [][]metav1.Condition{nil, {denied}} {
+			in := &v1alpha1.BucketAccess{
+				ObjectMeta: metav1.ObjectMeta{Name: "access", Namespace: "ns", CreationTimestamp: created, Annotations: map[string]string{"team": "a"}},
+				Spec:       v1alpha1.BucketAccessSpec{BucketClaimName: "claim", BucketAccessClassName: "class"},
+				Status:     v1alpha1.BucketAccessStatus{AccessGranted: granted, Conditions: conditions},
+			}
+
+			spoke := &BucketAccess{}
+			if err := spoke.ConvertFrom(in.DeepCopy()); err != nil {
+				t.Fatal(err)
+			}
+			out := &v1alpha1.BucketAccess{}
+			if err := spoke.ConvertTo(out); err != nil {
+				t.Fatal(err)
+			}
+			if !apiequality.Semantic.DeepEqual(in, out) {
+				t.Errorf("round-trip changed the bucket access:\n%+v\n%+v", in, out)
+			}
+		}
+	}
+}
+
+func TestConditionChangedAfterConversion(t *testing.T) {
+	in := &v1alpha1.Bucket{
+		ObjectMeta: metav1.ObjectMeta{Name: "bucket", CreationTimestamp: created},
+		Status:     v1alpha1.BucketStatus{BucketReady: true},
+	}
+
+	spoke := &Bucket{}
+	if err := spoke.ConvertFrom(in); err != nil {
+		t.Fatal(err)
+	}
+	if in.Annotations != nil {
+		t.Errorf("conversion modified the annotations of the source: %v", in.Annotations)
+	}
+
+	// a v1alpha2 client marks the bucket as not ready
+	notReady := condition(ConditionReady, metav1.ConditionFalse, "Deleting", "", later)
+	meta.SetStatusCondition(&spoke.Status.Conditions, notReady)
+
+	out := &v1alpha1.Bucket{}
+	if err := spoke.ConvertTo(out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Status.BucketReady {
+		t.Error("bucketReady is true, want the changed condition to win")
+	}
+	if len(out.Status.Conditions) != 1 || out.Status.Conditions[0].Reason != "Deleting" {
+		t.Errorf("conditions are %+v, want the changed condition", out.Status.Conditions)
+	}
+	if _, ok := out.Annotations[V1alpha1StatusAnnotation]; ok {
+		t.Errorf("%s was not removed", V1alpha1StatusAnnotation)
+	}
+}
+
+func TestInvalidStatusAnnotation(t *testing.T) {
+	in := fullBucket()
+	in.Annotations = map[string]string{V1alpha1StatusAnnotation: "true"}
+
+	if err := in.ConvertTo(&v1alpha1.Bucket{}); err == nil {
+		t.Error("expected an error for an invalid annotation")
+	}
+}
+
+func TestClassRoundTrip(t *testing.T) {
+	bucketClass := &BucketClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "class"},
+		Spec: BucketClassSpec{
+			DriverName:     "driver",
+			DeletionPolicy: DeletionPolicyRetain,
+			Parameters:     map[string]string{"tier": "cold"},
+			DefaultQuota:   fullBucket().Spec.Quota,
+			Features:       fullBucket().Spec.Features,
+			LifecycleRules: fullBucket().Spec.LifecycleRules,
+			Encryption:     fullBucket().Spec.Encryption,
+		},
+	}
+	hubClass := &v1alpha1.BucketClass{}
+	if err := bucketClass.DeepCopy().ConvertTo(hubClass); err != nil {
+		t.Fatal(err)
+	}
+	outClass := &BucketClass{}
+	if err := outClass.ConvertFrom(hubClass); err != nil {
+		t.Fatal(err)
+	}
+	if !apiequality.Semantic.DeepEqual(bucketClass, outClass) {
+		t.Errorf("round-trip changed the bucket class:\n%+v\n%+v", bucketClass, outClass)
+	}
+
+	accessClass := &BucketAccessClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "class"},
+		Spec: BucketAccessClassSpec{
+			DriverName:         "driver",
+			AuthenticationType: AuthenticationTypeKey,
+			Parameters:         map[string]string{"policy": "custom"},
+			AccessMode:         AccessModeReadOnly,
+			RotationPolicy: &CredentialRotationPolicy{
+				Interval:    metav1.Duration{Duration: 24 * time.Hour},
+				GracePeriod: &metav1.Duration{Duration: time.Hour},
+			},
+		},
+	}
+	hubAccessClass := &v1alpha1.BucketAccessClass{}
+	if err := accessClass.DeepCopy().ConvertTo(hubAccessClass); err != nil {
+		t.Fatal(err)
+	}
+	outAccessClass := &BucketAccessClass{}
+	if err := outAccessClass.ConvertFrom(hubAccessClass); err != nil {
+		t.Fatal(err)
+	}
+	if !apiequality.Semantic.DeepEqual(accessClass, outAccessClass) {
+		t.Errorf("round-trip changed the bucket access class:\n%+v\n%+v", accessClass, outAccessClass)
+	}
+
+	grant := &BucketClaimGrant{
+		ObjectMeta: metav1.ObjectMeta{Name: "grant", Namespace: "ns"},
+		Spec: BucketClaimGrantSpec{
+			From: []BucketClaimGrantFrom{{Namespace: "a"}, {Namespace: "b"}},
+			To:   []BucketClaimGrantTo{{Name: "claim"}},
+		},
+	}
+	hubGrant := &v1alpha1.BucketClaimGrant{}
+	if err := grant.DeepCopy().ConvertTo(hubGrant); err != nil {
+		t.Fatal(err)
+	}
+	outGrant := &BucketClaimGrant{}
+	if err := outGrant.ConvertFrom(hubGrant); err != nil {
+		t.Fatal(err)
+	}
+	if !apiequality.Semantic.DeepEqual(grant, outGrant) {
+		t.Errorf("round-trip changed the bucket claim grant:\n%+v\n%+v", grant, outGrant)
+	}
+}
+
+func fullBucket() *Bucket {
+	maxSize := resource.MustParse("10Gi")
+	return &Bucket{
+		ObjectMeta: metav1.ObjectMeta{Name: "bucket", CreationTimestamp: created},
+		Spec: BucketSpec{
+			DriverName:      "driver",
+			BucketClassName: "class",
+			BucketClaim:     &corev1.ObjectReference{Name: "claim", Namespace: "ns", UID: "uid"},
+			Protocols:       []Protocol{ProtocolS3, ProtocolGCP},
+			Parameters:      map[string]string{"tier": "cold"},
+			DeletionPolicy:  DeletionPolicyDelete,
+			Quota:           &BucketQuota{MaxSize: &maxSize, MaxObjects: int64Ptr(1000)},
+			Features: &BucketFeatures{
+				Versioning: true,
+				ObjectLock: &ObjectLock{Mode: ObjectLockModeGovernance, DefaultRetention: &metav1.Duration{Duration: time.Hour}},
+			},
+			LifecycleRules: []LifecycleRule{{
+				ID:             "archive",
+				Prefix:         "logs/",
+				ExpirationDays: int32Ptr(90),
+				Transitions:    []LifecycleTransition{{Days: 30, StorageClass: "GLACIER"}},
+			}},
+			Encryption: &BucketEncryption{
+				Algorithm:            EncryptionAlgorithmCustomerKey,
+				CustomerKeySecretRef: &corev1.SecretReference{Name: "key", Namespace: "ns"},
+			},
+		},
+		Status: BucketStatus{
+			BucketID:           "bucket-1234",
+			Usage:              &BucketUsage{Size: resource.MustParse("1Gi"), ObjectCount: 10, LastObservedTime: later},
+			AppliedFeatures:    &BucketFeatures{Versioning: true},
+			AppliedEncryption:  &BucketEncryption{Algorithm: EncryptionAlgorithmKMS, KMSKeyID: "key"},
+			ObservedGeneration: 2,
+		},
+	}
+}
+
+func fullBucketAccess() *BucketAccess {
+	return &BucketAccess{
+		ObjectMeta: metav1.ObjectMeta{Name: "access", Namespace: "ns", CreationTimestamp: created},
+		Spec: BucketAccessSpec{
+			BucketClaimName:       "claim",
+			BucketClaimNamespace:  "other",
+			Protocol:              ProtocolS3,
+			BucketAccessClassName: "class",
+			AccessMode:            AccessModeReadWrite,
+			CredentialsSecretName: "creds",
+		},
+		Status: BucketAccessStatus{
+			AccountID:            "account",
+			CredentialGeneration: 3,
+			LastRotationTime:     &later,
+			ObservedGeneration:   1,
+		},
+	}
+}
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
@@ -0,0 +1,26 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// +k8s:deepcopy-gen=package,register
+// +groupName=objectstorage.k8s.io
+// +k8s:protobuf-gen=package
+// +k8s:openapi-gen=true
+// +kubebuilder:validation:Required
+
+// Package v1alpha2 contains the v1alpha2 version of the objectstorage API.
+// v1alpha1 remains the storage version, and objects are converted between
+// the versions by the conversion webhook.
+package v1alpha2
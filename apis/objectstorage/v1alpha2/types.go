@@ -0,0 +1,667 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func init() {
+	SchemeBuilder.Register(&Bucket{}, &BucketList{})
+	SchemeBuilder.Register(&BucketClaim{}, &BucketClaimList{})
+	SchemeBuilder.Register(&BucketClaimGrant{}, &BucketClaimGrantList{})
+	SchemeBuilder.Register(&BucketClass{}, &BucketClassList{})
+
+	SchemeBuilder.Register(&BucketAccess{}, &BucketAccessList{})
+	SchemeBuilder.Register(&BucketAccessClass{}, &BucketAccessClassList{})
+}
+
+type DeletionPolicy string
+
+const (
+	DeletionPolicyRetain DeletionPolicy = "Retain"
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+)
+
+type Protocol string
+
+const (
+	ProtocolS3    Protocol = "S3"
+	ProtocolAzure Protocol = "Azure"
+	ProtocolGCP   Protocol = "GCP"
+)
+
+// IsDefaultClassAnnotation marks a BucketClass or BucketAccessClass as the
+// default for BucketClaims or BucketAccesses that do not specify a class.
+// Only the value "true" is recognized.
+const IsDefaultClassAnnotation = "objectstorage.k8s.io/is-default-class"
+
+type AuthenticationType string
+
+const (
+	AuthenticationTypeKey AuthenticationType = "Key"
+	AuthenticationTypeIAM AuthenticationType = "IAM"
+)
+
+// AccessMode is the level of access that credentials grant to a bucket
+type AccessMode string
+
+const (
+	AccessModeReadOnly  AccessMode = "ReadOnly"
+	AccessModeReadWrite AccessMode = "ReadWrite"
+	AccessModeWriteOnly AccessMode = "WriteOnly"
+)
+
+// ObjectLockMode is the mode in which objects are locked against deletion
+// and overwrites for their retention period
+type ObjectLockMode string
+
+const (
+	// ObjectLockModeGovernance allows users with special permissions to
+	// remove the lock before the retention period ends
+	ObjectLockModeGovernance ObjectLockMode = "Governance"
+
+	// ObjectLockModeCompliance does not allow any user to remove the lock
+	// before the retention period ends
+	ObjectLockModeCompliance ObjectLockMode = "Compliance"
+)
+
+// EncryptionAlgorithm is the kind of server-side encryption of a bucket
+type EncryptionAlgorithm string
+
+const (
+	// EncryptionAlgorithmProviderManaged encrypts objects with keys managed by the OSP
+	EncryptionAlgorithmProviderManaged EncryptionAlgorithm = "ProviderManaged"
+
+	// EncryptionAlgorithmKMS encrypts objects with a key from the key
+	// management service of the OSP
+	EncryptionAlgorithmKMS EncryptionAlgorithm = "KMS"
+
+	// EncryptionAlgorithmCustomerKey encrypts objects with a key that clients
+	// send with every request
+	EncryptionAlgorithmCustomerKey EncryptionAlgorithm = "CustomerKey"
+)
+
+// CustomerKeySecretKey is the key of the Secret referred to by
+// CustomerKeySecretRef that holds the 256 bit customer key
+const CustomerKeySecretKey = "key"
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+type Bucket struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec BucketSpec `json:"spec,omitempty"`
+
+	// +optional
+	Status BucketStatus `json:"status,omitempty"`
+}
+
+type BucketSpec struct {
+	// DriverName is the name of driver associated with this bucket
+	DriverName string `json:"driverName"`
+
+	// Name of the BucketClass specified in the BucketRequest
+	BucketClassName string `json:"bucketClassName"`
+
+	// Name of the BucketClaim that resulted in the creation of this Bucket
+	// In case the Bucket object was created manually, then this should refer
+	// to the BucketClaim with which this Bucket should be bound
+	BucketClaim *corev1.ObjectReference `json:"bucketClaim"`
+
+	// Protocols are the set of data APIs this bucket is expected to support.
+	// The possible values for protocol are:
+	// -  S3: Indicates Amazon S3 protocol
+	// -  Azure: Indicates Microsoft Azure BlobStore protocol
+	// -  GCS: Indicates Google Cloud Storage protocol
+	Protocols []Protocol `json:"protocols"`
+
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+
+	// DeletionPolicy is used to specify how COSI should handle deletion of this
+	// bucket. There are 2 possible values:
+	//  - Retain: Indicates that the bucket should not be deleted from the OSP (default)
+	//  - Delete: Indicates that the bucket should be deleted from the OSP
+	//        once all the workloads accessing this bucket are done
+	// +optional
+	// +kubebuilder:default:=Retain
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy"`
+
+	// ExistingBucketID is the unique id of the bucket in the OSP. This field should be
+	// used to specify a bucket that has been created outside of COSI.
+	// This field will be empty when the Bucket is dynamically provisioned by COSI.
+	// +optional
+	ExistingBucketID string `json:"existingBucketID,omitempty"`
+
+	// Quota limits the size of the bucket. It is copied from the BucketClaim,
+	// or from the BucketClass if the claim does not set it, and enforced by
+	// the driver.
+	// +optional
+	Quota *BucketQuota `json:"quota,omitempty"`
+
+	// Features are the data protection settings of the bucket. They are
+	// copied from the BucketClass and cannot be weakened once set.
+	// +optional
+	Features *BucketFeatures `json:"features,omitempty"`
+
+	// LifecycleRules expire objects and transition them to other storage
+	// classes of the OSP. They are copied from the BucketClass.
+	// +optional
+	// +listType=map
+	// +listMapKey=id
+	LifecycleRules []LifecycleRule `json:"lifecycleRules,omitempty"`
+
+	// Encryption is the server-side encryption of the bucket. It is copied
+	// from the BucketClass and cannot be removed once set.
+	// +optional
+	Encryption *BucketEncryption `json:"encryption,omitempty"`
+}
+
+type BucketStatus struct {
+	// BucketID is the unique id of the bucket in the OSP. This field will be
+	// populated by COSI.
+	// +optional
+	BucketID string `json:"bucketID,omitempty"`
+
+	// Usage is the most recent usage of the bucket reported by the driver
+	// +optional
+	Usage *BucketUsage `json:"usage,omitempty"`
+
+	// AppliedFeatures are the data protection settings that the driver
+	// applied to the bucket in the OSP
+	// +optional
+	AppliedFeatures *BucketFeatures `json:"appliedFeatures,omitempty"`
+
+	// AppliedLifecycleRules are the lifecycle rules that the driver applied
+	// to the bucket in the OSP
+	// +optional
+	// +listType=map
+	// +listMapKey=id
+	AppliedLifecycleRules []LifecycleRule `json:"appliedLifecycleRules,omitempty"`
+
+	// AppliedEncryption is the server-side encryption that the driver
+	// applied to the bucket in the OSP
+	// +optional
+	AppliedEncryption *BucketEncryption `json:"appliedEncryption,omitempty"`
+
+	// ObservedGeneration is the most recent generation of this object
+	// observed by the COSI controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the
+	// state of the bucket. The Ready condition reflects the successful
+	// creation of the bucket.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+type BucketQuota struct {
+	// MaxSize is the maximum total size of the objects in the bucket
+	// +optional
+	MaxSize *resource.Quantity `json:"maxSize,omitempty"`
+
+	// MaxObjects is the maximum number of objects in the bucket
+	// +optional
+	MaxObjects *int64 `json:"maxObjects,omitempty"`
+}
+
+type BucketFeatures struct {
+	// Versioning keeps previous versions of objects when they are
+	// overwritten or deleted
+	// +optional
+	Versioning bool `json:"versioning,omitempty"`
+
+	// ObjectLock prevents objects from being deleted or overwritten for a
+	// retention period. It requires Versioning.
+	// +optional
+	ObjectLock *ObjectLock `json:"objectLock,omitempty"`
+}
+
+type ObjectLock struct {
+	// Mode is the lock mode applied to new objects. It can be one of
+	// Governance - users with special permissions can remove the lock
+	// Compliance - no user can remove the lock
+	Mode ObjectLockMode `json:"mode"`
+
+	// DefaultRetention is the period for which new objects are locked
+	// +optional
+	DefaultRetention *metav1.Duration `json:"defaultRetention,omitempty"`
+}
+
+type LifecycleRule struct {
+	// ID identifies the rule. It must be unique within the list of rules.
+	ID string `json:"id"`
+
+	// Prefix limits the rule to objects whose key starts with it. The rule
+	// applies to all objects in the bucket if left empty.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// ExpirationDays is the number of days after their creation after which
+	// objects are deleted
+	// +optional
+	ExpirationDays *int32 `json:"expirationDays,omitempty"`
+
+	// NoncurrentVersionExpirationDays is the number of days after which
+	// previous versions of objects are deleted. It requires versioning.
+	// +optional
+	NoncurrentVersionExpirationDays *int32 `json:"noncurrentVersionExpirationDays,omitempty"`
+
+	// Transitions move objects to other storage classes of the OSP
+	// +optional
+	// +listType=atomic
+	Transitions []LifecycleTransition `json:"transitions,omitempty"`
+}
+
+type LifecycleTransition struct {
+	// Days is the number of days after their creation after which objects
+	// are moved to StorageClass
+	Days int32 `json:"days"`
+
+	// StorageClass is the name of the storage class in the OSP, e.g. GLACIER
+	StorageClass string `json:"storageClass"`
+}
+
+type BucketEncryption struct {
+	// Algorithm is the kind of server-side encryption. It can be one of
+	// ProviderManaged - keys are managed by the OSP
+	// KMS - a key from the key management service of the OSP is used
+	// CustomerKey - clients send the key with every request
+	Algorithm EncryptionAlgorithm `json:"algorithm"`
+
+	// KMSKeyID is the ID of the key in the key management service of the
+	// OSP. It is required with the KMS algorithm.
+	// +optional
+	KMSKeyID string `json:"kmsKeyID,omitempty"`
+
+	// CustomerKeySecretRef refers to the Secret holding the customer key
+	// under CustomerKeySecretKey. It is required with the CustomerKey algorithm.
+	// +optional
+	CustomerKeySecretRef *corev1.SecretReference `json:"customerKeySecretRef,omitempty"`
+}
+
+type BucketUsage struct {
+	// Size is the total size of the objects in the bucket
+	Size resource.Quantity `json:"size"`
+
+	// ObjectCount is the number of objects in the bucket
+	ObjectCount int64 `json:"objectCount"`
+
+	// LastObservedTime is the time at which the usage was observed
+	LastObservedTime metav1.Time `json:"lastObservedTime"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type BucketList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Bucket `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:subresource:status
+type BucketClaim struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec BucketClaimSpec `json:"spec,omitempty"`
+
+	// +optional
+	Status BucketClaimStatus `json:"status,omitempty"`
+}
+
+type BucketClaimSpec struct {
+	// Name of the BucketClass
+	BucketClassName string `json:"bucketClassName,omitempty"`
+
+	// Protocols are the set of data API this bucket is required to support.
+	// The possible values for protocol are:
+	// -  S3: Indicates Amazon S3 protocol
+	// -  Azure: Indicates Microsoft Azure BlobStore protocol
+	// -  GCS: Indicates Google Cloud Storage protocol
+	Protocols []Protocol `json:"protocols"`
+
+	// Name of a bucket object that was manually
+	// created to import a bucket created outside of COSI
+	// If unspecified, then a new Bucket will be dynamically provisioned
+	// +optional
+	ExistingBucketName string `json:"existingBucketName,omitempty"`
+
+	// Quota limits the size of the bucket. Limits that are not set are taken
+	// from the DefaultQuota of the BucketClass.
+	// +optional
+	Quota *BucketQuota `json:"quota,omitempty"`
+}
+
+type BucketClaimStatus struct {
+	// BucketName is the name of the provisioned Bucket in response
+	// to this BucketClaim. It is generated and set by the COSI controller
+	// before making the creation request to the OSP backend.
+	// +optional
+	BucketName string `json:"bucketName,omitempty"`
+
+	// ObservedGeneration is the most recent generation of this object
+	// observed by the COSI controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the
+	// state of the BucketClaim. The Ready condition indicates that the
+	// bucket is ready for consumption by workloads.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type BucketClaimList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BucketClaim `json:"items"`
+}
+
+// BucketClaimGrant allows BucketAccesses in other namespaces to refer to
+// BucketClaims in the namespace of the grant. A BucketAccess can only refer
+// to a BucketClaim in another namespace if a grant in the namespace of the
+// claim matches both the namespace of the BucketAccess and the claim.
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:scope=Namespaced
+type BucketClaimGrant struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec BucketClaimGrantSpec `json:"spec"`
+}
+
+type BucketClaimGrantSpec struct {
+	// From is the list of namespaces whose BucketAccesses may refer to the
+	// BucketClaims in To
+	// +listType=atomic
+	From []BucketClaimGrantFrom `json:"from"`
+
+	// To is the list of BucketClaims in the namespace of the grant that may
+	// be referred to
+	// +listType=atomic
+	To []BucketClaimGrantTo `json:"to"`
+}
+
+type BucketClaimGrantFrom struct {
+	// Namespace is the namespace of the BucketAccesses
+	Namespace string `json:"namespace"`
+}
+
+type BucketClaimGrantTo struct {
+	// Name is the name of the BucketClaim. All BucketClaims in the namespace
+	// of the grant may be referred to if left empty.
+	// +optional
+	Name string `json:"name,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type BucketClaimGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BucketClaimGrant `json:"items"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:scope=Cluster
+type BucketClass struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec BucketClassSpec `json:"spec"`
+}
+
+type BucketClassSpec struct {
+	// DriverName is the name of driver associated with this bucket
+	DriverName string `json:"driverName"`
+
+	// DeletionPolicy is used to specify how COSI should handle deletion of this
+	// bucket. There are 2 possible values:
+	//  - Retain: Indicates that the bucket should not be deleted from the OSP
+	//  - Delete: Indicates that the bucket should be deleted from the OSP
+	//        once all the workloads accessing this bucket are done
+	// +optional
+	// +kubebuilder:default:=Retain
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy"`
+
+	// Parameters is an opaque map for passing in configuration to a driver
+	// for creating the bucket
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+
+	// DefaultQuota limits the size of buckets of this class whose
+	// BucketClaims do not set a quota
+	// +optional
+	DefaultQuota *BucketQuota `json:"defaultQuota,omitempty"`
+
+	// Features are the data protection settings of buckets of this class
+	// +optional
+	Features *BucketFeatures `json:"features,omitempty"`
+
+	// LifecycleRules expire objects in buckets of this class and transition
+	// them to other storage classes of the OSP
+	// +optional
+	// +listType=map
+	// +listMapKey=id
+	LifecycleRules []LifecycleRule `json:"lifecycleRules,omitempty"`
+
+	// Encryption is the server-side encryption of buckets of this class
+	// +optional
+	Encryption *BucketEncryption `json:"encryption,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type BucketClassList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BucketClass `json:"items"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:scope=Cluster
+type BucketAccessClass struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec BucketAccessClassSpec `json:"spec"`
+}
+
+type BucketAccessClassSpec struct {
+	// DriverName is the name of driver associated with
+	// this BucketAccess
+	DriverName string `json:"driverName"`
+
+	// AuthenticationType denotes the style of authentication
+	// It can be one of
+	// Key - access, secret tokens based authentication
+	// IAM - implicit authentication of pods to the OSP based on service account mappings
+	AuthenticationType AuthenticationType `json:"authenticationType"`
+
+	// Parameters is an opaque map for passing in configuration to a driver
+	// for granting access to a bucket
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+
+	// AccessMode is the level of access granted to BucketAccesses of this
+	// class that do not specify one. It can be one of
+	// ReadOnly - objects can be listed and read
+	// ReadWrite - objects can be listed, read, written and deleted
+	// WriteOnly - objects can only be written
+	// Defaults to ReadWrite if unset.
+	// +optional
+	AccessMode AccessMode `json:"accessMode,omitempty"`
+
+	// RotationPolicy specifies how often the credentials of BucketAccesses
+	// of this class are rotated. Credentials are never rotated if unset.
+	// +optional
+	RotationPolicy *CredentialRotationPolicy `json:"rotationPolicy,omitempty"`
+}
+
+type CredentialRotationPolicy struct {
+	// Interval is the maximum age of credentials before they are rotated
+	Interval metav1.Duration `json:"interval"`
+
+	// GracePeriod is the duration for which the previous credentials remain
+	// valid after a rotation, so that workloads can pick up the new ones.
+	// The previous credentials are revoked immediately if unset.
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type BucketAccessClassList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BucketAccessClass `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:subresource:status
+type BucketAccess struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec BucketAccessSpec `json:"spec,omitempty"`
+
+	// +optional
+	Status BucketAccessStatus `json:"status,omitempty"`
+}
+
+type BucketAccessSpec struct {
+	// BucketClaimName is the name of the BucketClaim.
+	BucketClaimName string `json:"bucketClaimName"`
+
+	// BucketClaimNamespace is the namespace of the BucketClaim. If left empty,
+	// the BucketClaim is in the namespace of the BucketAccess. A BucketClaim in
+	// another namespace can only be referred to if a BucketClaimGrant in that
+	// namespace allows it.
+	// +optional
+	BucketClaimNamespace string `json:"bucketClaimNamespace,omitempty"`
+
+	// Protocol is the name of the Protocol
+	// that this access credential is supposed to support
+	// If left empty, it will choose the protocol supported
+	// by the bucket. If the bucket supports multiple protocols,
+	// the end protocol is determined by the driver.
+	// +optional
+	Protocol Protocol `json:"protocol,omitempty"`
+
+	// BucketAccessClassName is the name of the BucketAccessClass
+	BucketAccessClassName string `json:"bucketAccessClassName"`
+
+	// AccessMode is the level of access requested for the bucket. It can be
+	// one of ReadOnly, ReadWrite or WriteOnly. If left empty, the AccessMode
+	// of the BucketAccessClass is used.
+	// +optional
+	AccessMode AccessMode `json:"accessMode,omitempty"`
+
+	// CredentialsSecretName is the name of the secret that COSI should populate
+	// with the credentials. If a secret by this name already exists, then it is
+	// assumed that credentials have already been generated. It is not overridden,
+	// unless the BucketAccessClass specifies a RotationPolicy.
+	// This secret is deleted when the BucketAccess is deleted.
+	CredentialsSecretName string `json:"credentialsSecretName"`
+
+	// ServiceAccountName is the name of the serviceAccount that COSI will map
+	// to the OSP service account when IAM styled authentication is specified
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+}
+
+type BucketAccessStatus struct {
+	// AccountID is the unique ID for the account in the OSP. It will be populated
+	// by the COSI sidecar once access has been successfully granted.
+	// +optional
+	AccountID string `json:"accountID,omitempty"`
+
+	// CredentialGeneration is incremented every time new credentials are
+	// written to the CredentialsSecretName secret
+	// +optional
+	CredentialGeneration int64 `json:"credentialGeneration,omitempty"`
+
+	// LastRotationTime is the time at which the current credentials were minted
+	// +optional
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+
+	// PreviousCredentialsExpiryTime is the time at which the credentials replaced
+	// by the last rotation are revoked. It is unset once they have been revoked.
+	// +optional
+	PreviousCredentialsExpiryTime *metav1.Time `json:"previousCredentialsExpiryTime,omitempty"`
+
+	// ObservedGeneration is the most recent generation of this object
+	// observed by the COSI controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the
+	// state of the BucketAccess. The AccessGranted condition indicates the
+	// successful grant of privileges to access the bucket.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type BucketAccessList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BucketAccess `json:"items"`
+}
@@ -26,11 +26,13 @@ import (
 	rest "k8s.io/client-go/rest"
 	flowcontrol "k8s.io/client-go/util/flowcontrol"
 	objectstoragev1alpha1 "sigs.k8s.io/container-object-storage-interface-api/client/clientset/versioned/typed/objectstorage/v1alpha1"
+	objectstoragev1alpha2 "sigs.k8s.io/container-object-storage-interface-api/client/clientset/versioned/typed/objectstorage/v1alpha2"
 )
 
 type Interface interface {
 	Discovery() discovery.DiscoveryInterface
 	ObjectstorageV1alpha1() objectstoragev1alpha1.ObjectstorageV1alpha1Interface
+	ObjectstorageV1alpha2() objectstoragev1alpha2.ObjectstorageV1alpha2Interface
 }
 
 // Clientset contains the clients for groups. Each group has exactly one
@@ -38,6 +40,7 @@ type Interface interface {
 type Clientset struct {
 	*discovery.DiscoveryClient
 	objectstorageV1alpha1 *objectstoragev1alpha1.ObjectstorageV1alpha1Client
+	objectstorageV1alpha2 *objectstoragev1alpha2.ObjectstorageV1alpha2Client
 }
 
 // ObjectstorageV1alpha1 retrieves the ObjectstorageV1alpha1Client
@@ -45,6 +48,11 @@ func (c *Clientset) ObjectstorageV1alpha1() objectstoragev1alpha1.ObjectstorageV
 	return c.objectstorageV1alpha1
 }
 
+// ObjectstorageV1alpha2 retrieves the ObjectstorageV1alpha2Client
+func (c *Clientset) ObjectstorageV1alpha2() objectstoragev1alpha2.ObjectstorageV1alpha2Interface {
+	return c.objectstorageV1alpha2
+}
+
 // Discovery retrieves the DiscoveryClient
 func (c *Clientset) Discovery() discovery.DiscoveryInterface {
 	if c == nil {
@@ -93,6 +101,10 @@ func NewForConfigAndClient(c *rest.Config, httpClient *http.Client) (*Clientset,
 	if err != nil {
 		return nil, err
 	}
+	cs.objectstorageV1alpha2, err = objectstoragev1alpha2.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 
 	cs.DiscoveryClient, err = discovery.NewDiscoveryClientForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
@@ -115,6 +127,7 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 func New(c rest.Interface) *Clientset {
 	var cs Clientset
 	cs.objectstorageV1alpha1 = objectstoragev1alpha1.New(c)
+	cs.objectstorageV1alpha2 = objectstoragev1alpha2.New(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClient(c)
 	return &cs
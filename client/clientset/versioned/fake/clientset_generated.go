@@ -27,6 +27,8 @@ import (
 	clientset "sigs.k8s.io/container-object-storage-interface-api/client/clientset/versioned"
 	objectstoragev1alpha1 "sigs.k8s.io/container-object-storage-interface-api/client/clientset/versioned/typed/objectstorage/v1alpha1"
 	fakeobjectstoragev1alpha1 "sigs.k8s.io/container-object-storage-interface-api/client/clientset/versioned/typed/objectstorage/v1alpha1/fake"
+	objectstoragev1alpha2 "sigs.k8s.io/container-object-storage-interface-api/client/clientset/versioned/typed/objectstorage/v1alpha2"
+	fakeobjectstoragev1alpha2 "sigs.k8s.io/container-object-storage-interface-api/client/clientset/versioned/typed/objectstorage/v1alpha2/fake"
 )
 
 // NewSimpleClientset returns a clientset that will respond with the provided objects.
@@ -83,3 +85,8 @@ var (
 func (c *Clientset) ObjectstorageV1alpha1() objectstoragev1alpha1.ObjectstorageV1alpha1Interface {
 	return &fakeobjectstoragev1alpha1.FakeObjectstorageV1alpha1{Fake: &c.Fake}
 }
+
+// ObjectstorageV1alpha2 retrieves the ObjectstorageV1alpha2Client
+func (c *Clientset) ObjectstorageV1alpha2() objectstoragev1alpha2.ObjectstorageV1alpha2Interface {
+	return &fakeobjectstoragev1alpha2.FakeObjectstorageV1alpha2{Fake: &c.Fake}
+}
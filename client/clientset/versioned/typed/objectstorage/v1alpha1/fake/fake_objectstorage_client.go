@@ -44,6 +44,10 @@ func (c *FakeObjectstorageV1alpha1) BucketClaims(namespace string) v1alpha1.Buck
 	return &FakeBucketClaims{c, namespace}
 }
 
+func (c *FakeObjectstorageV1alpha1) BucketClaimGrants(namespace string) v1alpha1.BucketClaimGrantInterface {
+	return &FakeBucketClaimGrants{c, namespace}
+}
+
 func (c *FakeObjectstorageV1alpha1) BucketClasses() v1alpha1.BucketClassInterface {
 	return &FakeBucketClasses{c}
 }
@@ -26,4 +26,6 @@ type BucketAccessClassExpansion interface{}
 
 type BucketClaimExpansion interface{}
 
+type BucketClaimGrantExpansion interface{}
+
 type BucketClassExpansion interface{}
@@ -32,6 +32,7 @@ type ObjectstorageV1alpha1Interface interface {
 	BucketAccessesGetter
 	BucketAccessClassesGetter
 	BucketClaimsGetter
+	BucketClaimGrantsGetter
 	BucketClassesGetter
 }
 
@@ -56,6 +57,10 @@ func (c *ObjectstorageV1alpha1Client) BucketClaims(namespace string) BucketClaim
 	return newBucketClaims(c, namespace)
 }
 
+func (c *ObjectstorageV1alpha1Client) BucketClaimGrants(namespace string) BucketClaimGrantInterface {
+	return newBucketClaimGrants(c, namespace)
+}
+
 func (c *ObjectstorageV1alpha1Client) BucketClasses() BucketClassInterface {
 	return newBucketClasses(c)
 }
@@ -24,6 +24,7 @@ import (
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	cache "k8s.io/client-go/tools/cache"
 	v1alpha1 "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+	v1alpha2 "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2"
 )
 
 // GenericInformer is type of SharedIndexInformer which will locate and delegate to other
@@ -61,9 +62,25 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Objectstorage().V1alpha1().BucketAccessClasses().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("bucketclaims"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Objectstorage().V1alpha1().BucketClaims().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("bucketclaimgrants"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Objectstorage().V1alpha1().BucketClaimGrants().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("bucketclasses"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Objectstorage().V1alpha1().BucketClasses().Informer()}, nil
 
+		// Group=objectstorage.k8s.io, Version=v1alpha2
+	case v1alpha2.SchemeGroupVersion.WithResource("buckets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Objectstorage().V1alpha2().Buckets().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("bucketaccesses"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Objectstorage().V1alpha2().BucketAccesses().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("bucketaccessclasses"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Objectstorage().V1alpha2().BucketAccessClasses().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("bucketclaims"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Objectstorage().V1alpha2().BucketClaims().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("bucketclaimgrants"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Objectstorage().V1alpha2().BucketClaimGrants().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("bucketclasses"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Objectstorage().V1alpha2().BucketClasses().Informer()}, nil
+
 	}
 
 	return nil, fmt.Errorf("no informer found for %v", resource)
@@ -32,6 +32,8 @@ type Interface interface {
 	BucketAccessClasses() BucketAccessClassInformer
 	// BucketClaims returns a BucketClaimInformer.
 	BucketClaims() BucketClaimInformer
+	// BucketClaimGrants returns a BucketClaimGrantInformer.
+	BucketClaimGrants() BucketClaimGrantInformer
 	// BucketClasses returns a BucketClassInformer.
 	BucketClasses() BucketClassInformer
 }
@@ -67,6 +69,11 @@ func (v *version) BucketClaims() BucketClaimInformer {
 	return &bucketClaimInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// BucketClaimGrants returns a BucketClaimGrantInformer.
+func (v *version) BucketClaimGrants() BucketClaimGrantInformer {
+	return &bucketClaimGrantInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // BucketClasses returns a BucketClassInformer.
 func (v *version) BucketClasses() BucketClassInformer {
 	return &bucketClassInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -42,6 +42,14 @@ type BucketClaimListerExpansion interface{}
 // BucketClaimNamespaceLister.
 type BucketClaimNamespaceListerExpansion interface{}
 
+// BucketClaimGrantListerExpansion allows custom methods to be added to
+// BucketClaimGrantLister.
+type BucketClaimGrantListerExpansion interface{}
+
+// BucketClaimGrantNamespaceListerExpansion allows custom methods to be added to
+// BucketClaimGrantNamespaceLister.
+type BucketClaimGrantNamespaceListerExpansion interface{}
+
 // BucketClassListerExpansion allows custom methods to be added to
 // BucketClassLister.
 type BucketClassListerExpansion interface{}
@@ -31,74 +31,125 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroup":                                                        schema_pkg_apis_meta_v1_APIGroup(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroupList":                                                    schema_pkg_apis_meta_v1_APIGroupList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResource":                                                     schema_pkg_apis_meta_v1_APIResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResourceList":                                                 schema_pkg_apis_meta_v1_APIResourceList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIVersions":                                                     schema_pkg_apis_meta_v1_APIVersions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ApplyOptions":                                                    schema_pkg_apis_meta_v1_ApplyOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Condition":                                                       schema_pkg_apis_meta_v1_Condition(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.CreateOptions":                                                   schema_pkg_apis_meta_v1_CreateOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.DeleteOptions":                                                   schema_pkg_apis_meta_v1_DeleteOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Duration":                                                        schema_pkg_apis_meta_v1_Duration(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.FieldsV1":                                                        schema_pkg_apis_meta_v1_FieldsV1(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GetOptions":                                                      schema_pkg_apis_meta_v1_GetOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind":                                                       schema_pkg_apis_meta_v1_GroupKind(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupResource":                                                   schema_pkg_apis_meta_v1_GroupResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersion":                                                    schema_pkg_apis_meta_v1_GroupVersion(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionForDiscovery":                                        schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionKind":                                                schema_pkg_apis_meta_v1_GroupVersionKind(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionResource":                                            schema_pkg_apis_meta_v1_GroupVersionResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.InternalEvent":                                                   schema_pkg_apis_meta_v1_InternalEvent(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector":                                                   schema_pkg_apis_meta_v1_LabelSelector(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement":                                        schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.List":                                                            schema_pkg_apis_meta_v1_List(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta":                                                        schema_pkg_apis_meta_v1_ListMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ListOptions":                                                     schema_pkg_apis_meta_v1_ListOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ManagedFieldsEntry":                                              schema_pkg_apis_meta_v1_ManagedFieldsEntry(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime":                                                       schema_pkg_apis_meta_v1_MicroTime(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta":                                                      schema_pkg_apis_meta_v1_ObjectMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.OwnerReference":                                                  schema_pkg_apis_meta_v1_OwnerReference(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadata":                                           schema_pkg_apis_meta_v1_PartialObjectMetadata(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadataList":                                       schema_pkg_apis_meta_v1_PartialObjectMetadataList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Patch":                                                           schema_pkg_apis_meta_v1_Patch(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.PatchOptions":                                                    schema_pkg_apis_meta_v1_PatchOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Preconditions":                                                   schema_pkg_apis_meta_v1_Preconditions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.RootPaths":                                                       schema_pkg_apis_meta_v1_RootPaths(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ServerAddressByClientCIDR":                                       schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Status":                                                          schema_pkg_apis_meta_v1_Status(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusCause":                                                     schema_pkg_apis_meta_v1_StatusCause(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusDetails":                                                   schema_pkg_apis_meta_v1_StatusDetails(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Table":                                                           schema_pkg_apis_meta_v1_Table(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableColumnDefinition":                                           schema_pkg_apis_meta_v1_TableColumnDefinition(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableOptions":                                                    schema_pkg_apis_meta_v1_TableOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRow":                                                        schema_pkg_apis_meta_v1_TableRow(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRowCondition":                                               schema_pkg_apis_meta_v1_TableRowCondition(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Time":                                                            schema_pkg_apis_meta_v1_Time(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Timestamp":                                                       schema_pkg_apis_meta_v1_Timestamp(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta":                                                        schema_pkg_apis_meta_v1_TypeMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.UpdateOptions":                                                   schema_pkg_apis_meta_v1_UpdateOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.WatchEvent":                                                      schema_pkg_apis_meta_v1_WatchEvent(ref),
-		"k8s.io/apimachinery/pkg/runtime.RawExtension":                                                         schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
-		"k8s.io/apimachinery/pkg/runtime.TypeMeta":                                                             schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
-		"k8s.io/apimachinery/pkg/runtime.Unknown":                                                              schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
-		"k8s.io/apimachinery/pkg/version.Info":                                                                 schema_k8sio_apimachinery_pkg_version_Info(ref),
-		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.Bucket":                schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_Bucket(ref),
-		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketAccess":          schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketAccess(ref),
-		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketAccessClass":     schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketAccessClass(ref),
-		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketAccessClassList": schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketAccessClassList(ref),
-		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketAccessList":      schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketAccessList(ref),
-		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketAccessSpec":      schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketAccessSpec(ref),
-		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketAccessStatus":    schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketAccessStatus(ref),
-		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClaim":           schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketClaim(ref),
-		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClaimList":       schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketClaimList(ref),
-		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClaimSpec":       schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketClaimSpec(ref),
-		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClaimStatus":     schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketClaimStatus(ref),
-		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClass":           schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketClass(ref),
-		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClassList":       schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketClassList(ref),
-		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketList":            schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketList(ref),
-		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketSpec":            schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketSpec(ref),
-		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketStatus":          schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketStatus(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroup":                                                           schema_pkg_apis_meta_v1_APIGroup(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroupList":                                                       schema_pkg_apis_meta_v1_APIGroupList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResource":                                                        schema_pkg_apis_meta_v1_APIResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResourceList":                                                    schema_pkg_apis_meta_v1_APIResourceList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIVersions":                                                        schema_pkg_apis_meta_v1_APIVersions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ApplyOptions":                                                       schema_pkg_apis_meta_v1_ApplyOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Condition":                                                          schema_pkg_apis_meta_v1_Condition(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.CreateOptions":                                                      schema_pkg_apis_meta_v1_CreateOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.DeleteOptions":                                                      schema_pkg_apis_meta_v1_DeleteOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Duration":                                                           schema_pkg_apis_meta_v1_Duration(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.FieldsV1":                                                           schema_pkg_apis_meta_v1_FieldsV1(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GetOptions":                                                         schema_pkg_apis_meta_v1_GetOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind":                                                          schema_pkg_apis_meta_v1_GroupKind(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupResource":                                                      schema_pkg_apis_meta_v1_GroupResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersion":                                                       schema_pkg_apis_meta_v1_GroupVersion(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionForDiscovery":                                           schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionKind":                                                   schema_pkg_apis_meta_v1_GroupVersionKind(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionResource":                                               schema_pkg_apis_meta_v1_GroupVersionResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.InternalEvent":                                                      schema_pkg_apis_meta_v1_InternalEvent(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector":                                                      schema_pkg_apis_meta_v1_LabelSelector(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement":                                           schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.List":                                                               schema_pkg_apis_meta_v1_List(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta":                                                           schema_pkg_apis_meta_v1_ListMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ListOptions":                                                        schema_pkg_apis_meta_v1_ListOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ManagedFieldsEntry":                                                 schema_pkg_apis_meta_v1_ManagedFieldsEntry(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime":                                                          schema_pkg_apis_meta_v1_MicroTime(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta":                                                         schema_pkg_apis_meta_v1_ObjectMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.OwnerReference":                                                     schema_pkg_apis_meta_v1_OwnerReference(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadata":                                              schema_pkg_apis_meta_v1_PartialObjectMetadata(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadataList":                                          schema_pkg_apis_meta_v1_PartialObjectMetadataList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Patch":                                                              schema_pkg_apis_meta_v1_Patch(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.PatchOptions":                                                       schema_pkg_apis_meta_v1_PatchOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Preconditions":                                                      schema_pkg_apis_meta_v1_Preconditions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.RootPaths":                                                          schema_pkg_apis_meta_v1_RootPaths(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ServerAddressByClientCIDR":                                          schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Status":                                                             schema_pkg_apis_meta_v1_Status(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusCause":                                                        schema_pkg_apis_meta_v1_StatusCause(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusDetails":                                                      schema_pkg_apis_meta_v1_StatusDetails(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Table":                                                              schema_pkg_apis_meta_v1_Table(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableColumnDefinition":                                              schema_pkg_apis_meta_v1_TableColumnDefinition(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableOptions":                                                       schema_pkg_apis_meta_v1_TableOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRow":                                                           schema_pkg_apis_meta_v1_TableRow(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRowCondition":                                                  schema_pkg_apis_meta_v1_TableRowCondition(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Time":                                                               schema_pkg_apis_meta_v1_Time(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Timestamp":                                                          schema_pkg_apis_meta_v1_Timestamp(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta":                                                           schema_pkg_apis_meta_v1_TypeMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.UpdateOptions":                                                      schema_pkg_apis_meta_v1_UpdateOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.WatchEvent":                                                         schema_pkg_apis_meta_v1_WatchEvent(ref),
+		"k8s.io/apimachinery/pkg/runtime.RawExtension":                                                            schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
+		"k8s.io/apimachinery/pkg/runtime.TypeMeta":                                                                schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
+		"k8s.io/apimachinery/pkg/runtime.Unknown":                                                                 schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
+		"k8s.io/apimachinery/pkg/version.Info":                                                                    schema_k8sio_apimachinery_pkg_version_Info(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis.BucketInfo":                                      schema_sigsk8sio_container_object_storage_interface_api_apis_BucketInfo(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis.BucketInfoSpec":                                  schema_sigsk8sio_container_object_storage_interface_api_apis_BucketInfoSpec(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis.EncryptionInfo":                                  schema_sigsk8sio_container_object_storage_interface_api_apis_EncryptionInfo(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis.SecretAzure":                                     schema_sigsk8sio_container_object_storage_interface_api_apis_SecretAzure(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis.SecretGCS":                                       schema_sigsk8sio_container_object_storage_interface_api_apis_SecretGCS(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis.SecretS3":                                        schema_sigsk8sio_container_object_storage_interface_api_apis_SecretS3(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis.iniSection":                                      schema_sigsk8sio_container_object_storage_interface_api_apis_iniSection(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.Bucket":                   schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_Bucket(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketAccess":             schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketAccess(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketAccessClass":        schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketAccessClass(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketAccessClassList":    schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketAccessClassList(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketAccessList":         schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketAccessList(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketAccessSpec":         schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketAccessSpec(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketAccessStatus":       schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketAccessStatus(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClaim":              schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketClaim(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClaimGrant":         schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketClaimGrant(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClaimGrantFrom":     schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketClaimGrantFrom(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClaimGrantList":     schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketClaimGrantList(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClaimGrantSpec":     schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketClaimGrantSpec(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClaimGrantTo":       schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketClaimGrantTo(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClaimList":          schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketClaimList(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClaimSpec":          schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketClaimSpec(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClaimStatus":        schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketClaimStatus(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClass":              schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketClass(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClassList":          schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketClassList(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketEncryption":         schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketEncryption(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketFeatures":           schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketFeatures(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketList":               schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketList(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketQuota":              schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketQuota(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketSpec":               schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketSpec(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketStatus":             schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketStatus(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketUsage":              schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketUsage(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.CredentialRotationPolicy": schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_CredentialRotationPolicy(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.LifecycleRule":            schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_LifecycleRule(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.LifecycleTransition":      schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_LifecycleTransition(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.ObjectLock":               schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_ObjectLock(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.Bucket":                   schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_Bucket(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketAccess":             schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketAccess(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketAccessClass":        schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketAccessClass(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketAccessClassList":    schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketAccessClassList(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketAccessClassSpec":    schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketAccessClassSpec(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketAccessList":         schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketAccessList(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketAccessSpec":         schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketAccessSpec(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketAccessStatus":       schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketAccessStatus(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketClaim":              schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketClaim(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketClaimGrant":         schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketClaimGrant(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketClaimGrantFrom":     schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketClaimGrantFrom(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketClaimGrantList":     schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketClaimGrantList(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketClaimGrantSpec":     schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketClaimGrantSpec(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketClaimGrantTo":       schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketClaimGrantTo(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketClaimList":          schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketClaimList(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketClaimSpec":          schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketClaimSpec(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketClaimStatus":        schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketClaimStatus(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketClass":              schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketClass(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketClassList":          schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketClassList(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketClassSpec":          schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketClassSpec(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketEncryption":         schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketEncryption(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketFeatures":           schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketFeatures(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketList":               schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketList(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketQuota":              schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketQuota(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketSpec":               schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketSpec(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketStatus":             schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketStatus(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketUsage":              schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketUsage(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.CredentialRotationPolicy": schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_CredentialRotationPolicy(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.LifecycleRule":            schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_LifecycleRule(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.LifecycleTransition":      schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_LifecycleTransition(ref),
+		"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.ObjectLock":               schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_ObjectLock(ref),
 	}
 }
 
@@ -2539,7 +2590,7 @@ func schema_k8sio_apimachinery_pkg_version_Info(ref common.ReferenceCallback) co
 	}
 }
 
-func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_Bucket(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_container_object_storage_interface_api_apis_BucketInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -2568,114 +2619,118 @@ func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_B
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketSpec"),
-						},
-					},
-					"status": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketStatus"),
+							Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis.BucketInfoSpec"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketSpec", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "sigs.k8s.io/container-object-storage-interface-api/apis.BucketInfoSpec"},
 	}
 }
 
-func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_container_object_storage_interface_api_apis_BucketInfoSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"bucketName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "BucketName is the name of the Bucket",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"authenticationType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "AuthenticationType denotes the style of authentication It can be one of KEY - access, secret tokens based authentication IAM - implicit authentication of pods to the OSP based on service account mappings",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"accessMode": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "AccessMode is the level of access granted by the credentials It can be one of ReadOnly - objects can be listed and read ReadWrite - objects can be listed, read, written and deleted WriteOnly - objects can only be written",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"spec": {
+					"secretS3": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketAccessSpec"),
+							Description: "S3 - Details of S3 credentials",
+							Ref:         ref("sigs.k8s.io/container-object-storage-interface-api/apis.SecretS3"),
 						},
 					},
-					"status": {
+					"secretAzure": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketAccessStatus"),
+							Description: "Azure - Details of Azure credentials",
+							Ref:         ref("sigs.k8s.io/container-object-storage-interface-api/apis.SecretAzure"),
+						},
+					},
+					"secretGCS": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GCS - Details of GCS credentials",
+							Ref:         ref("sigs.k8s.io/container-object-storage-interface-api/apis.SecretGCS"),
+						},
+					},
+					"encryption": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Encryption - Details of the server-side encryption of the bucket",
+							Ref:         ref("sigs.k8s.io/container-object-storage-interface-api/apis.EncryptionInfo"),
+						},
+					},
+					"protocols": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Protocols are the set of data APIs this bucket is expected to support. The possible values for protocol are: -  S3: Indicates Amazon S3 protocol -  Azure: Indicates Microsoft Azure BlobStore protocol -  GCS: Indicates Google Cloud Storage protocol",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"bucketName", "authenticationType", "secretS3", "secretAzure", "secretGCS", "protocols"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketAccessSpec", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketAccessStatus"},
+			"sigs.k8s.io/container-object-storage-interface-api/apis.EncryptionInfo", "sigs.k8s.io/container-object-storage-interface-api/apis.SecretAzure", "sigs.k8s.io/container-object-storage-interface-api/apis.SecretGCS", "sigs.k8s.io/container-object-storage-interface-api/apis.SecretS3"},
 	}
 }
 
-func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketAccessClass(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_container_object_storage_interface_api_apis_EncryptionInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"driverName": {
+					"algorithm": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DriverName is the name of driver associated with this BucketAccess",
+							Description: "Algorithm is the kind of server-side encryption of the bucket",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"authenticationType": {
+					"kmsKeyID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AuthenticationType denotes the style of authentication It can be one of KEY - access, secret tokens based authentication IAM - implicit authentication of pods to the OSP based on service account mappings",
-							Default:     "",
+							Description: "KMSKeyID is the ID of the key in the key management service of the OSP",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"parameters": {
+					"headers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Parameters is an opaque map for passing in configuration to a driver for granting access to a bucket",
+							Description: "Headers are the S3 request headers that clients must send to comply with the encryption of the bucket. With the CustomerKey algorithm they include the key, and must be sent with every request.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -2690,189 +2745,174 @@ func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_B
 						},
 					},
 				},
-				Required: []string{"driverName", "authenticationType"},
+				Required: []string{"algorithm"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketAccessClassList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_container_object_storage_interface_api_apis_SecretAzure(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"metadata": {
+					"accessToken": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"items": {
+					"expiryTimeStamp": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketAccessClass"),
-									},
-								},
-							},
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"accessToken", "expiryTimeStamp"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketAccessClass"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketAccessList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_container_object_storage_interface_api_apis_SecretGCS(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"projectID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "ProjectID is the ID of the project that the bucket belongs to",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"serviceAccount": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "ServiceAccount is the email of the service account that access was granted to. With IAM authentication, workloads impersonate this account through workload identity.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"privateKeyName": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "PrivateKeyName is the name of the private key of the service account in the OSP",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"items": {
+					"privateKeyData": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketAccess"),
-									},
-								},
-							},
+							Description: "PrivateKeyData is the JSON key file of the service account. It is empty with IAM authentication.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"projectID", "serviceAccount"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketAccess"},
 	}
 }
 
-func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketAccessSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_container_object_storage_interface_api_apis_SecretS3(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"bucketClaimName": {
-						SchemaProps: spec.SchemaProps{
-							Description: "BucketClaimName is the name of the BucketClaim.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"protocol": {
+					"endpoint": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Protocol is the name of the Protocol that this access credential is supposed to support If left empty, it will choose the protocol supported by the bucket. If the bucket supports multiple protocols, the end protocol is determined by the driver.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"bucketAccessClassName": {
+					"region": {
 						SchemaProps: spec.SchemaProps{
-							Description: "BucketAccessClassName is the name of the BucketAccessClass",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"credentialsSecretName": {
+					"accessKeyID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CredentialsSecretName is the name of the secret that COSI should populate with the credentials. If a secret by this name already exists, then it is assumed that credentials have already been generated. It is not overridden. This secret is deleted when the BucketAccess is delted.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"serviceAccountName": {
+					"accessSecretKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ServiceAccountName is the name of the serviceAccount that COSI will map to the OSP service account when IAM styled authentication is specified",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
 				},
-				Required: []string{"bucketClaimName", "bucketAccessClassName", "credentialsSecretName"},
+				Required: []string{"endpoint", "region", "accessKeyID", "accessSecretKey"},
 			},
 		},
 	}
 }
 
-func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketAccessStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_container_object_storage_interface_api_apis_iniSection(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "iniSection is a named section of an INI style configuration file. Keys keep the order in which they were set, so that rendered files are stable.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"accountID": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AccountID is the unique ID for the account in the OSP. It will be populated by the COSI sidecar once access has been successfully granted.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"accessGranted": {
+					"keys": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AccessGranted indicates the successful grant of privileges to access the bucket",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"values": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"name", "keys", "values"},
 			},
 		},
 	}
 }
 
-func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketClaim(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_Bucket(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -2901,24 +2941,24 @@ func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_B
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClaimSpec"),
+							Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClaimStatus"),
+							Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClaimSpec", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClaimStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketSpec", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketStatus"},
 	}
 }
 
-func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketClaimList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -2941,102 +2981,30 @@ func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_B
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"items": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClaim"),
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketAccessSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketAccessStatus"),
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClaim"},
-	}
-}
-
-func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketClaimSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"bucketClassName": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Name of the BucketClass",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"protocols": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Protocols are the set of data API this bucket is required to support. The possible values for protocol are: -  S3: Indicates Amazon S3 protocol -  Azure: Indicates Microsoft Azure BlobStore protocol -  GCS: Indicates Google Cloud Storage protocol",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"existingBucketName": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Name of a bucket object that was manually created to import a bucket created outside of COSI If unspecified, then a new Bucket will be dynamically provisioned",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-				},
-				Required: []string{"protocols"},
-			},
-		},
-	}
-}
-
-func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketClaimStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"bucketReady": {
-						SchemaProps: spec.SchemaProps{
-							Description: "BucketReady indicates that the bucket is ready for consumpotion by workloads",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"bucketName": {
-						SchemaProps: spec.SchemaProps{
-							Description: "BucketName is the name of the provisioned Bucket in response to this BucketClaim. It is generated and set by the COSI controller before making the creation request to the OSP backend.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-				},
-				Required: []string{"bucketReady"},
-			},
-		},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketAccessSpec", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketAccessStatus"},
 	}
 }
 
-func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketClass(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketAccessClass(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -3064,15 +3032,15 @@ func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_B
 					},
 					"driverName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DriverName is the name of driver associated with this bucket",
+							Description: "DriverName is the name of driver associated with this BucketAccess",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"deletionPolicy": {
+					"authenticationType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeletionPolicy is used to specify how COSI should handle deletion of this bucket. There are 2 possible values:\n - Retain: Indicates that the bucket should not be deleted from the OSP\n - Delete: Indicates that the bucket should be deleted from the OSP\n       once all the workloads accessing this bucket are done",
+							Description: "AuthenticationType denotes the style of authentication It can be one of Key - access, secret tokens based authentication IAM - implicit authentication of pods to the OSP based on service account mappings",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
@@ -3080,7 +3048,7 @@ func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_B
 					},
 					"parameters": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Parameters is an opaque map for passing in configuration to a driver for creating the bucket",
+							Description: "Parameters is an opaque map for passing in configuration to a driver for granting access to a bucket",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -3094,16 +3062,29 @@ func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_B
 							},
 						},
 					},
+					"accessMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AccessMode is the level of access granted to BucketAccesses of this class that do not specify one. It can be one of ReadOnly - objects can be listed and read ReadWrite - objects can be listed, read, written and deleted WriteOnly - objects can only be written Defaults to ReadWrite if unset.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rotationPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RotationPolicy specifies how often the credentials of BucketAccesses of this class are rotated. Credentials are never rotated if unset.",
+							Ref:         ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.CredentialRotationPolicy"),
+						},
+					},
 				},
-				Required: []string{"driverName", "deletionPolicy"},
+				Required: []string{"driverName", "authenticationType"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.CredentialRotationPolicy"},
 	}
 }
 
-func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketClassList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketAccessClassList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -3136,7 +3117,7 @@ func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_B
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClass"),
+										Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketAccessClass"),
 									},
 								},
 							},
@@ -3147,11 +3128,11 @@ func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_B
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClass"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketAccessClass"},
 	}
 }
 
-func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketAccessList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -3184,7 +3165,7 @@ func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_B
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.Bucket"),
+										Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketAccess"),
 									},
 								},
 							},
@@ -3195,114 +3176,2756 @@ func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_B
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.Bucket"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketAccess"},
 	}
 }
 
-func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketAccessSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"driverName": {
+					"bucketClaimName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DriverName is the name of driver associated with this bucket",
+							Description: "BucketClaimName is the name of the BucketClaim.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"bucketClassName": {
+					"bucketClaimNamespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the BucketClass specified in the BucketRequest",
-							Default:     "",
+							Description: "BucketClaimNamespace is the namespace of the BucketClaim. If left empty, the BucketClaim is in the namespace of the BucketAccess. A BucketClaim in another namespace can only be referred to if a BucketClaimGrant in that namespace allows it.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"bucketClaim": {
+					"protocol": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the BucketClaim that resulted in the creation of this Bucket In case the Bucket object was created manually, then this should refer to the BucketClaim with which this Bucket should be bound",
-							Ref:         ref("k8s.io/api/core/v1.ObjectReference"),
+							Description: "Protocol is the name of the Protocol that this access credential is supposed to support If left empty, it will choose the protocol supported by the bucket. If the bucket supports multiple protocols, the end protocol is determined by the driver.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"protocols": {
+					"bucketAccessClassName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Protocols are the set of data APIs this bucket is expected to support. The possible values for protocol are: -  S3: Indicates Amazon S3 protocol -  Azure: Indicates Microsoft Azure BlobStore protocol -  GCS: Indicates Google Cloud Storage protocol",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "BucketAccessClassName is the name of the BucketAccessClass",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"parameters": {
+					"accessMode": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "AccessMode is the level of access requested for the bucket. It can be one of ReadOnly, ReadWrite or WriteOnly. If left empty, the AccessMode of the BucketAccessClass is used.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"deletionPolicy": {
+					"credentialsSecretName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeletionPolicy is used to specify how COSI should handle deletion of this bucket. There are 2 possible values:\n - Retain: Indicates that the bucket should not be deleted from the OSP (default)\n - Delete: Indicates that the bucket should be deleted from the OSP\n       once all the workloads accessing this bucket are done",
+							Description: "CredentialsSecretName is the name of the secret that COSI should populate with the credentials. If a secret by this name already exists, then it is assumed that credentials have already been generated. It is not overridden, unless the BucketAccessClass specifies a RotationPolicy. This secret is deleted when the BucketAccess is delted.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"existingBucketID": {
+					"serviceAccountName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExistingBucketID is the unique id of the bucket in the OSP. This field should be used to specify a bucket that has been created outside of COSI. This field will be empty when the Bucket is dynamically provisioned by COSI.",
+							Description: "ServiceAccountName is the name of the serviceAccount that COSI will map to the OSP service account when IAM styled authentication is specified",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"driverName", "bucketClassName", "bucketClaim", "protocols"},
+				Required: []string{"bucketClaimName", "bucketAccessClassName", "credentialsSecretName"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/api/core/v1.ObjectReference"},
 	}
 }
 
-func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketAccessStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"bucketReady": {
+					"accountID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "BucketReady is a boolean condition to reflect the successful creation of a bucket.",
+							Description: "AccountID is the unique ID for the account in the OSP. It will be populated by the COSI sidecar once access has been successfully granted.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"accessGranted": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AccessGranted indicates the successful grant of privileges to access the bucket",
+							Default:     false,
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"bucketID": {
+					"credentialGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "BucketID is the unique id of the bucket in the OSP. This field will be populated by COSI.",
+							Description: "CredentialGeneration is incremented every time new credentials are written to the CredentialsSecretName secret",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastRotationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastRotationTime is the time at which the current credentials were minted",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"previousCredentialsExpiryTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PreviousCredentialsExpiryTime is the time at which the credentials replaced by the last rotation are revoked. It is unset once they have been revoked.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation of this object observed by the COSI controller.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type":       "map",
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions represent the latest available observations of the state of the BucketAccess.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketClaim(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClaimSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClaimStatus"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClaimSpec", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClaimStatus"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketClaimGrant(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BucketClaimGrant allows BucketAccesses in other namespaces to refer to BucketClaims in the namespace of the grant. A BucketAccess can only refer to a BucketClaim in another namespace if a grant in the namespace of the claim matches both the namespace of the BucketAccess and the claim.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClaimGrantSpec"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClaimGrantSpec"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketClaimGrantFrom(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the BucketAccesses",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"namespace"},
+			},
+		},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketClaimGrantList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClaimGrant"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClaimGrant"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketClaimGrantSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"from": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "From is the list of namespaces whose BucketAccesses may refer to the BucketClaims in To",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClaimGrantFrom"),
+									},
+								},
+							},
+						},
+					},
+					"to": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "To is the list of BucketClaims in the namespace of the grant that may be referred to",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClaimGrantTo"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"from", "to"},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClaimGrantFrom", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClaimGrantTo"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketClaimGrantTo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the BucketClaim. All BucketClaims in the namespace of the grant may be referred to if left empty.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketClaimList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClaim"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClaim"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketClaimSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"bucketClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the BucketClass",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"protocols": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Protocols are the set of data API this bucket is required to support. The possible values for protocol are: -  S3: Indicates Amazon S3 protocol -  Azure: Indicates Microsoft Azure BlobStore protocol -  GCS: Indicates Google Cloud Storage protocol",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"existingBucketName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of a bucket object that was manually created to import a bucket created outside of COSI If unspecified, then a new Bucket will be dynamically provisioned",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"quota": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Quota limits the size of the bucket. Limits that are not set are taken from the DefaultQuota of the BucketClass.",
+							Ref:         ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketQuota"),
+						},
+					},
+				},
+				Required: []string{"protocols"},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketQuota"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketClaimStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"bucketReady": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BucketReady indicates that the bucket is ready for consumpotion by workloads",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"bucketName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BucketName is the name of the provisioned Bucket in response to this BucketClaim. It is generated and set by the COSI controller before making the creation request to the OSP backend.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation of this object observed by the COSI controller.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type":       "map",
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions represent the latest available observations of the state of the BucketClaim.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"bucketReady"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketClass(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"driverName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DriverName is the name of driver associated with this bucket",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deletionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionPolicy is used to specify how COSI should handle deletion of this bucket. There are 2 possible values:\n - Retain: Indicates that the bucket should not be deleted from the OSP\n - Delete: Indicates that the bucket should be deleted from the OSP\n       once all the workloads accessing this bucket are done",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"parameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parameters is an opaque map for passing in configuration to a driver for creating the bucket",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"defaultQuota": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultQuota limits the size of buckets of this class whose BucketClaims do not set a quota",
+							Ref:         ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketQuota"),
+						},
+					},
+					"features": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Features are the data protection settings of buckets of this class",
+							Ref:         ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketFeatures"),
+						},
+					},
+					"lifecycleRules": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"id",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "LifecycleRules expire objects in buckets of this class and transition them to other storage classes of the OSP",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.LifecycleRule"),
+									},
+								},
+							},
+						},
+					},
+					"encryption": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Encryption is the server-side encryption of buckets of this class",
+							Ref:         ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketEncryption"),
+						},
+					},
+				},
+				Required: []string{"driverName", "deletionPolicy"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketEncryption", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketFeatures", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketQuota", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.LifecycleRule"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketClassList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClass"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketClass"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketEncryption(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"algorithm": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Algorithm is the kind of server-side encryption. It can be one of ProviderManaged - keys are managed by the OSP KMS - a key from the key management service of the OSP is used CustomerKey - clients send the key with every request",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kmsKeyID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KMSKeyID is the ID of the key in the key management service of the OSP. It is required with the KMS algorithm.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"customerKeySecretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CustomerKeySecretRef refers to the Secret holding the customer key under CustomerKeySecretKey. It is required with the CustomerKey algorithm.",
+							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
+						},
+					},
+				},
+				Required: []string{"algorithm"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretReference"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketFeatures(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"versioning": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Versioning keeps previous versions of objects when they are overwritten or deleted",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"objectLock": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObjectLock prevents objects from being deleted or overwritten for a retention period. It requires Versioning.",
+							Ref:         ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.ObjectLock"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.ObjectLock"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.Bucket"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.Bucket"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketQuota(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSize is the maximum total size of the objects in the bucket",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"maxObjects": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxObjects is the maximum number of objects in the bucket",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"driverName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DriverName is the name of driver associated with this bucket",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bucketClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the BucketClass specified in the BucketRequest",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bucketClaim": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the BucketClaim that resulted in the creation of this Bucket In case the Bucket object was created manually, then this should refer to the BucketClaim with which this Bucket should be bound",
+							Ref:         ref("k8s.io/api/core/v1.ObjectReference"),
+						},
+					},
+					"protocols": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Protocols are the set of data APIs this bucket is expected to support. The possible values for protocol are: -  S3: Indicates Amazon S3 protocol -  Azure: Indicates Microsoft Azure BlobStore protocol -  GCS: Indicates Google Cloud Storage protocol",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"parameters": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"deletionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionPolicy is used to specify how COSI should handle deletion of this bucket. There are 2 possible values:\n - Retain: Indicates that the bucket should not be deleted from the OSP (default)\n - Delete: Indicates that the bucket should be deleted from the OSP\n       once all the workloads accessing this bucket are done",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"existingBucketID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExistingBucketID is the unique id of the bucket in the OSP. This field should be used to specify a bucket that has been created outside of COSI. This field will be empty when the Bucket is dynamically provisioned by COSI.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"quota": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Quota limits the size of the bucket. It is copied from the BucketClaim, or from the BucketClass if the claim does not set it, and enforced by the driver.",
+							Ref:         ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketQuota"),
+						},
+					},
+					"features": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Features are the data protection settings of the bucket. They are copied from the BucketClass and cannot be weakened once set.",
+							Ref:         ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketFeatures"),
+						},
+					},
+					"lifecycleRules": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"id",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "LifecycleRules expire objects and transition them to other storage classes of the OSP. They are copied from the BucketClass.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.LifecycleRule"),
+									},
+								},
+							},
+						},
+					},
+					"encryption": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Encryption is the server-side encryption of the bucket. It is copied from the BucketClass and cannot be removed once set.",
+							Ref:         ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketEncryption"),
+						},
+					},
+				},
+				Required: []string{"driverName", "bucketClassName", "bucketClaim", "protocols"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.ObjectReference", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketEncryption", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketFeatures", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketQuota", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.LifecycleRule"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"bucketReady": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BucketReady is a boolean condition to reflect the successful creation of a bucket.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"bucketID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BucketID is the unique id of the bucket in the OSP. This field will be populated by COSI.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"usage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Usage is the most recent usage of the bucket reported by the driver",
+							Ref:         ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketUsage"),
+						},
+					},
+					"appliedFeatures": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AppliedFeatures are the data protection settings that the driver applied to the bucket in the OSP",
+							Ref:         ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketFeatures"),
+						},
+					},
+					"appliedLifecycleRules": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"id",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AppliedLifecycleRules are the lifecycle rules that the driver applied to the bucket in the OSP",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.LifecycleRule"),
+									},
+								},
+							},
+						},
+					},
+					"appliedEncryption": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AppliedEncryption is the server-side encryption that the driver applied to the bucket in the OSP",
+							Ref:         ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketEncryption"),
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation of this object observed by the COSI controller.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type":       "map",
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions represent the latest available observations of the state of the bucket.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketEncryption", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketFeatures", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.BucketUsage", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.LifecycleRule"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_BucketUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Size is the total size of the objects in the bucket",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"objectCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObjectCount is the number of objects in the bucket",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastObservedTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastObservedTime is the time at which the usage was observed",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"size", "objectCount", "lastObservedTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_CredentialRotationPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval is the maximum age of credentials before they are rotated",
+							Default:     0,
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"gracePeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GracePeriod is the duration for which the previous credentials remain valid after a rotation, so that workloads can pick up the new ones. The previous credentials are revoked immediately if unset.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"interval"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_LifecycleRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID identifies the rule. It must be unique within the list of rules.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"prefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefix limits the rule to objects whose key starts with it. The rule applies to all objects in the bucket if left empty.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expirationDays": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationDays is the number of days after their creation after which objects are deleted",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"noncurrentVersionExpirationDays": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NoncurrentVersionExpirationDays is the number of days after which previous versions of objects are deleted. It requires versioning.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"transitions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Transitions move objects to other storage classes of the OSP",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.LifecycleTransition"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"id"},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1.LifecycleTransition"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_LifecycleTransition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"days": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Days is the number of days after their creation after which objects are moved to StorageClass",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"storageClass": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StorageClass is the name of the storage class in the OSP, e.g. GLACIER",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"days", "storageClass"},
+			},
+		},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha1_ObjectLock(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mode is the lock mode applied to new objects. It can be one of Governance - users with special permissions can remove the lock Compliance - no user can remove the lock",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"defaultRetention": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultRetention is the period for which new objects are locked",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"mode"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_Bucket(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketSpec", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketStatus"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketAccessSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketAccessStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketAccessSpec", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketAccessStatus"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketAccessClass(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketAccessClassSpec"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketAccessClassSpec"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketAccessClassList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketAccessClass"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketAccessClass"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketAccessClassSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"driverName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DriverName is the name of driver associated with this BucketAccess",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"authenticationType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AuthenticationType denotes the style of authentication It can be one of Key - access, secret tokens based authentication IAM - implicit authentication of pods to the OSP based on service account mappings",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"parameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parameters is an opaque map for passing in configuration to a driver for granting access to a bucket",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"accessMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AccessMode is the level of access granted to BucketAccesses of this class that do not specify one. It can be one of ReadOnly - objects can be listed and read ReadWrite - objects can be listed, read, written and deleted WriteOnly - objects can only be written Defaults to ReadWrite if unset.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rotationPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RotationPolicy specifies how often the credentials of BucketAccesses of this class are rotated. Credentials are never rotated if unset.",
+							Ref:         ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.CredentialRotationPolicy"),
+						},
+					},
+				},
+				Required: []string{"driverName", "authenticationType"},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.CredentialRotationPolicy"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketAccessList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketAccess"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketAccess"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketAccessSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"bucketClaimName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BucketClaimName is the name of the BucketClaim.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bucketClaimNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BucketClaimNamespace is the namespace of the BucketClaim. If left empty, the BucketClaim is in the namespace of the BucketAccess. A BucketClaim in another namespace can only be referred to if a BucketClaimGrant in that namespace allows it.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"protocol": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Protocol is the name of the Protocol that this access credential is supposed to support If left empty, it will choose the protocol supported by the bucket. If the bucket supports multiple protocols, the end protocol is determined by the driver.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bucketAccessClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BucketAccessClassName is the name of the BucketAccessClass",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"accessMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AccessMode is the level of access requested for the bucket. It can be one of ReadOnly, ReadWrite or WriteOnly. If left empty, the AccessMode of the BucketAccessClass is used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"credentialsSecretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialsSecretName is the name of the secret that COSI should populate with the credentials. If a secret by this name already exists, then it is assumed that credentials have already been generated. It is not overridden, unless the BucketAccessClass specifies a RotationPolicy. This secret is deleted when the BucketAccess is deleted.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"serviceAccountName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceAccountName is the name of the serviceAccount that COSI will map to the OSP service account when IAM styled authentication is specified",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"bucketClaimName", "bucketAccessClassName", "credentialsSecretName"},
+			},
+		},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketAccessStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"accountID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AccountID is the unique ID for the account in the OSP. It will be populated by the COSI sidecar once access has been successfully granted.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"credentialGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialGeneration is incremented every time new credentials are written to the CredentialsSecretName secret",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastRotationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastRotationTime is the time at which the current credentials were minted",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"previousCredentialsExpiryTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PreviousCredentialsExpiryTime is the time at which the credentials replaced by the last rotation are revoked. It is unset once they have been revoked.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation of this object observed by the COSI controller.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type":       "map",
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions represent the latest available observations of the state of the BucketAccess. The AccessGranted condition indicates the successful grant of privileges to access the bucket.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketClaim(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketClaimSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketClaimStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketClaimSpec", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketClaimStatus"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketClaimGrant(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BucketClaimGrant allows BucketAccesses in other namespaces to refer to BucketClaims in the namespace of the grant. A BucketAccess can only refer to a BucketClaim in another namespace if a grant in the namespace of the claim matches both the namespace of the BucketAccess and the claim.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketClaimGrantSpec"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketClaimGrantSpec"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketClaimGrantFrom(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the BucketAccesses",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"namespace"},
+			},
+		},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketClaimGrantList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketClaimGrant"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketClaimGrant"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketClaimGrantSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"from": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "From is the list of namespaces whose BucketAccesses may refer to the BucketClaims in To",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketClaimGrantFrom"),
+									},
+								},
+							},
+						},
+					},
+					"to": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "To is the list of BucketClaims in the namespace of the grant that may be referred to",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketClaimGrantTo"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"from", "to"},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketClaimGrantFrom", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketClaimGrantTo"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketClaimGrantTo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the BucketClaim. All BucketClaims in the namespace of the grant may be referred to if left empty.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketClaimList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketClaim"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketClaim"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketClaimSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"bucketClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the BucketClass",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"protocols": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Protocols are the set of data API this bucket is required to support. The possible values for protocol are: -  S3: Indicates Amazon S3 protocol -  Azure: Indicates Microsoft Azure BlobStore protocol -  GCS: Indicates Google Cloud Storage protocol",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"existingBucketName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of a bucket object that was manually created to import a bucket created outside of COSI If unspecified, then a new Bucket will be dynamically provisioned",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"quota": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Quota limits the size of the bucket. Limits that are not set are taken from the DefaultQuota of the BucketClass.",
+							Ref:         ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketQuota"),
+						},
+					},
+				},
+				Required: []string{"protocols"},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketQuota"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketClaimStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"bucketName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BucketName is the name of the provisioned Bucket in response to this BucketClaim. It is generated and set by the COSI controller before making the creation request to the OSP backend.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation of this object observed by the COSI controller.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type":       "map",
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions represent the latest available observations of the state of the BucketClaim. The Ready condition indicates that the bucket is ready for consumption by workloads.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketClass(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketClassSpec"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketClassSpec"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketClassList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketClass"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketClass"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketClassSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"driverName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DriverName is the name of driver associated with this bucket",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deletionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionPolicy is used to specify how COSI should handle deletion of this bucket. There are 2 possible values:\n - Retain: Indicates that the bucket should not be deleted from the OSP\n - Delete: Indicates that the bucket should be deleted from the OSP\n       once all the workloads accessing this bucket are done",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"parameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parameters is an opaque map for passing in configuration to a driver for creating the bucket",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"defaultQuota": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultQuota limits the size of buckets of this class whose BucketClaims do not set a quota",
+							Ref:         ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketQuota"),
+						},
+					},
+					"features": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Features are the data protection settings of buckets of this class",
+							Ref:         ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketFeatures"),
+						},
+					},
+					"lifecycleRules": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"id",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "LifecycleRules expire objects in buckets of this class and transition them to other storage classes of the OSP",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.LifecycleRule"),
+									},
+								},
+							},
+						},
+					},
+					"encryption": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Encryption is the server-side encryption of buckets of this class",
+							Ref:         ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketEncryption"),
+						},
+					},
+				},
+				Required: []string{"driverName"},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketEncryption", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketFeatures", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketQuota", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.LifecycleRule"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketEncryption(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"algorithm": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Algorithm is the kind of server-side encryption. It can be one of ProviderManaged - keys are managed by the OSP KMS - a key from the key management service of the OSP is used CustomerKey - clients send the key with every request",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kmsKeyID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KMSKeyID is the ID of the key in the key management service of the OSP. It is required with the KMS algorithm.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"customerKeySecretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CustomerKeySecretRef refers to the Secret holding the customer key under CustomerKeySecretKey. It is required with the CustomerKey algorithm.",
+							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
+						},
+					},
+				},
+				Required: []string{"algorithm"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretReference"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketFeatures(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"versioning": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Versioning keeps previous versions of objects when they are overwritten or deleted",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"objectLock": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObjectLock prevents objects from being deleted or overwritten for a retention period. It requires Versioning.",
+							Ref:         ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.ObjectLock"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.ObjectLock"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.Bucket"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.Bucket"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketQuota(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSize is the maximum total size of the objects in the bucket",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"maxObjects": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxObjects is the maximum number of objects in the bucket",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"driverName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DriverName is the name of driver associated with this bucket",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bucketClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the BucketClass specified in the BucketRequest",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bucketClaim": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the BucketClaim that resulted in the creation of this Bucket In case the Bucket object was created manually, then this should refer to the BucketClaim with which this Bucket should be bound",
+							Ref:         ref("k8s.io/api/core/v1.ObjectReference"),
+						},
+					},
+					"protocols": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Protocols are the set of data APIs this bucket is expected to support. The possible values for protocol are: -  S3: Indicates Amazon S3 protocol -  Azure: Indicates Microsoft Azure BlobStore protocol -  GCS: Indicates Google Cloud Storage protocol",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"parameters": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"deletionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionPolicy is used to specify how COSI should handle deletion of this bucket. There are 2 possible values:\n - Retain: Indicates that the bucket should not be deleted from the OSP (default)\n - Delete: Indicates that the bucket should be deleted from the OSP\n       once all the workloads accessing this bucket are done",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"existingBucketID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExistingBucketID is the unique id of the bucket in the OSP. This field should be used to specify a bucket that has been created outside of COSI. This field will be empty when the Bucket is dynamically provisioned by COSI.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"quota": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Quota limits the size of the bucket. It is copied from the BucketClaim, or from the BucketClass if the claim does not set it, and enforced by the driver.",
+							Ref:         ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketQuota"),
+						},
+					},
+					"features": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Features are the data protection settings of the bucket. They are copied from the BucketClass and cannot be weakened once set.",
+							Ref:         ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketFeatures"),
+						},
+					},
+					"lifecycleRules": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"id",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "LifecycleRules expire objects and transition them to other storage classes of the OSP. They are copied from the BucketClass.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.LifecycleRule"),
+									},
+								},
+							},
+						},
+					},
+					"encryption": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Encryption is the server-side encryption of the bucket. It is copied from the BucketClass and cannot be removed once set.",
+							Ref:         ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketEncryption"),
+						},
+					},
+				},
+				Required: []string{"driverName", "bucketClassName", "bucketClaim", "protocols"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.ObjectReference", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketEncryption", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketFeatures", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketQuota", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.LifecycleRule"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"bucketID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BucketID is the unique id of the bucket in the OSP. This field will be populated by COSI.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"usage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Usage is the most recent usage of the bucket reported by the driver",
+							Ref:         ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketUsage"),
+						},
+					},
+					"appliedFeatures": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AppliedFeatures are the data protection settings that the driver applied to the bucket in the OSP",
+							Ref:         ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketFeatures"),
+						},
+					},
+					"appliedLifecycleRules": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"id",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AppliedLifecycleRules are the lifecycle rules that the driver applied to the bucket in the OSP",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.LifecycleRule"),
+									},
+								},
+							},
+						},
+					},
+					"appliedEncryption": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AppliedEncryption is the server-side encryption that the driver applied to the bucket in the OSP",
+							Ref:         ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketEncryption"),
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation of this object observed by the COSI controller.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type":       "map",
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions represent the latest available observations of the state of the bucket. The Ready condition reflects the successful creation of the bucket.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketEncryption", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketFeatures", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.BucketUsage", "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.LifecycleRule"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_BucketUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Size is the total size of the objects in the bucket",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"objectCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObjectCount is the number of objects in the bucket",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastObservedTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastObservedTime is the time at which the usage was observed",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"size", "objectCount", "lastObservedTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_CredentialRotationPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval is the maximum age of credentials before they are rotated",
+							Default:     0,
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"gracePeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GracePeriod is the duration for which the previous credentials remain valid after a rotation, so that workloads can pick up the new ones. The previous credentials are revoked immediately if unset.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"interval"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_LifecycleRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID identifies the rule. It must be unique within the list of rules.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"prefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefix limits the rule to objects whose key starts with it. The rule applies to all objects in the bucket if left empty.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expirationDays": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationDays is the number of days after their creation after which objects are deleted",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"noncurrentVersionExpirationDays": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NoncurrentVersionExpirationDays is the number of days after which previous versions of objects are deleted. It requires versioning.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"transitions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Transitions move objects to other storage classes of the OSP",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.LifecycleTransition"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"id"},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2.LifecycleTransition"},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_LifecycleTransition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"days": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Days is the number of days after their creation after which objects are moved to StorageClass",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"storageClass": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StorageClass is the name of the storage class in the OSP, e.g. GLACIER",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"days", "storageClass"},
+			},
+		},
+	}
+}
+
+func schema_container_object_storage_interface_api_apis_objectstorage_v1alpha2_ObjectLock(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mode is the lock mode applied to new objects. It can be one of Governance - users with special permissions can remove the lock Compliance - no user can remove the lock",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"defaultRetention": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultRetention is the period for which new objects are locked",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"mode"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
@@ -0,0 +1,181 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// cosi-conformance checks that a COSI driver implements the Provisioner
+// services as required by the COSI spec. It creates and deletes buckets in the
+// object store of the driver.
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"regexp"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+	"sigs.k8s.io/container-object-storage-interface-api/conformance"
+	cosi "sigs.k8s.io/container-object-storage-interface-spec"
+)
+
+type options struct {
+	endpoint               string
+	protocol               string
+	region                 string
+	parameters             map[string]string
+	incompatibleParameters map[string]string
+	accessParameters       map[string]string
+	accessPolicy           string
+	namePrefix             string
+	timeout                time.Duration
+	focus                  string
+	junitPath              string
+	jsonPath               string
+}
+
+func main() {
+	// an interrupted run still removes the buckets and accounts it created
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	err := newCommand().ExecuteContext(ctx)
+	stop()
+	if err != nil {
+		os.Exit(1)
+	}
+}
+
+func newCommand() *cobra.Command {
+	opts := &options{}
+
+	cmd := &cobra.Command{
+		Use:   "cosi-conformance --endpoint SOCKET",
+		Short: "Check a COSI driver against the COSI spec",
+		Long: `Check a COSI driver against the COSI spec. The checks create and delete
+buckets and accounts in the object store of the driver, and remove them
+again when they are done. The command fails if any check fails.`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), cmd.OutOrStdout(), opts)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&opts.endpoint, "endpoint", "", "Unix socket of the driver, as a path or unix:// URL")
+	flags.StringVar(&opts.protocol, "protocol", "S3", "Protocol of the buckets created by the checks: S3, Azure or GCP")
+	flags.StringVar(&opts.region, "region", "", "Region of the S3 buckets created by the checks")
+	flags.StringToStringVar(&opts.parameters, "parameter", nil, "Parameters passed to the driver when creating buckets, as key=value")
+	flags.StringToStringVar(&opts.incompatibleParameters, "incompatible-parameter", nil, "Parameters that the driver must reject for an existing bucket created with --parameter. The ALREADY_EXISTS check is skipped if unset.")
+	flags.StringToStringVar(&opts.accessParameters, "access-parameter", nil, "Parameters passed to the driver when granting access, as key=value")
+	flags.StringVar(&opts.accessPolicy, "access-policy", "", "Access policy passed to the driver when granting access")
+	flags.StringVar(&opts.namePrefix, "name-prefix", "cosi-conformance", "Prefix of the names of the buckets and accounts created by the checks")
+	flags.DurationVar(&opts.timeout, "timeout", 30*time.Second, "Timeout of each RPC")
+	flags.StringVar(&opts.focus, "focus", "", "Only run the checks whose name matches this regular expression")
+	flags.StringVar(&opts.junitPath, "junit", "", "Write a JUnit XML report to this file")
+	flags.StringVar(&opts.jsonPath, "json", "", "Write a JSON report to this file")
+	cobra.MarkFlagRequired(flags, "endpoint")
+	return cmd
+}
+
+func run(ctx context.Context, out io.Writer, opts *options) error {
+	protocol, err := newProtocol(opts.protocol, opts.region)
+	if err != nil {
+		return err
+	}
+	cfg := conformance.Config{
+		Protocol:               protocol,
+		Parameters:             opts.parameters,
+		IncompatibleParameters: opts.incompatibleParameters,
+		AccessParameters:       opts.accessParameters,
+		AccessPolicy:           opts.accessPolicy,
+		NamePrefix:             opts.namePrefix,
+		Timeout:                opts.timeout,
+	}
+	if opts.focus != "" {
+		if cfg.Focus, err = regexp.Compile(opts.focus); err != nil {
+			return fmt.Errorf("invalid --focus: %w", err)
+		}
+	}
+
+	endpoint := opts.endpoint
+	if !strings.HasPrefix(endpoint, "unix://") {
+		endpoint = "unix://" + endpoint
+	}
+	conn, err := grpc.DialContext(ctx, endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", opts.endpoint, err)
+	}
+	defer conn.Close()
+
+	report, err := conformance.Run(ctx, conn, cfg)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Driver: %s\n", report.Driver)
+	for _, result := range report.Results {
+		line := fmt.Sprintf("%-7s %s (%s)", strings.ToUpper(string(result.Status)), result.Name, result.Duration.Round(time.Millisecond))
+		if result.Message != "" {
+			line += ": " + result.Message
+		}
+		fmt.Fprintln(out, line)
+	}
+	fmt.Fprintf(out, "%d passed, %d failed, %d skipped\n",
+		report.Count(conformance.StatusPassed), report.Count(conformance.StatusFailed), report.Count(conformance.StatusSkipped))
+
+	if opts.junitPath != "" {
+		if err := writeReport(opts.junitPath, report.JUnit); err != nil {
+			return err
+		}
+	}
+	if opts.jsonPath != "" {
+		if err := writeReport(opts.jsonPath, report.JSON); err != nil {
+			return err
+		}
+	}
+
+	if !report.Passed() {
+		return fmt.Errorf("%d checks failed", report.Count(conformance.StatusFailed))
+	}
+	return nil
+}
+
+func newProtocol(name, region string) (*cosi.Protocol, error) {
+	switch v1alpha1.Protocol(name) {
+	case v1alpha1.ProtocolS3:
+		return &cosi.Protocol{Type: &cosi.Protocol_S3{S3: &cosi.S3{Region: region}}}, nil
+	case v1alpha1.ProtocolAzure:
+		return &cosi.Protocol{Type: &cosi.Protocol_AzureBlob{AzureBlob: &cosi.AzureBlob{}}}, nil
+	case v1alpha1.ProtocolGCP:
+		return &cosi.Protocol{Type: &cosi.Protocol_Gcs{Gcs: &cosi.GCS{}}}, nil
+	}
+	return nil, fmt.Errorf("unsupported protocol %q, must be one of S3, Azure or GCP", name)
+}
+
+func writeReport(path string, encode func() ([]byte, error)) error {
+	data, err := encode()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cosiapi "sigs.k8s.io/container-object-storage-interface-api/apis"
+)
+
+// credentialsFormats are the output formats of the credentials command
+var credentialsFormats = []string{"json", "env", "aws-credentials", "aws-config", "rclone", "s3cmd"}
+
+func newCredentialsCommand(clientOpts *clientOptions) *cobra.Command {
+	var output, profile string
+
+	cmd := &cobra.Command{
+		Use:   "credentials BUCKETACCESS",
+		Short: "Decode the BucketInfo in the credentials Secret of a BucketAccess",
+		Long: `Decode the BucketInfo in the credentials Secret of a BucketAccess. The
+output contains the credentials in clear text.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !containsString(credentialsFormats, output) {
+				return fmt.Errorf("unknown output format %q, must be one of %v", output, credentialsFormats)
+			}
+
+			namespace, err := clientOpts.namespace()
+			if err != nil {
+				return err
+			}
+			client, err := clientOpts.bucketClient()
+			if err != nil {
+				return err
+			}
+			kubeClient, err := clientOpts.kubeClient()
+			if err != nil {
+				return err
+			}
+
+			access, err := client.ObjectstorageV1alpha1().BucketAccesses(namespace).Get(cmd.Context(), args[0], metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			secret, err := kubeClient.CoreV1().Secrets(namespace).Get(cmd.Context(), access.Spec.CredentialsSecretName, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			info, err := cosiapi.BucketInfoFromSecretData(secret.Data)
+			if err != nil {
+				return fmt.Errorf("secret %s/%s: %w", secret.Namespace, secret.Name, err)
+			}
+
+			var data []byte
+			switch output {
+			case "json":
+				data, err = marshalIndent(info)
+			case "env":
+				data = []byte(strings.Join(info.EnvList(), "\n") + "\n")
+			case "aws-credentials":
+				data, err = info.AWSCredentialsFile(profile)
+			case "aws-config":
+				data, err = info.AWSConfigFile(profile)
+			case "rclone":
+				data, err = info.RcloneConfig(profile)
+			case "s3cmd":
+				data, err = info.S3cmdConfig()
+			}
+			if err != nil {
+				return err
+			}
+			_, err = cmd.OutOrStdout().Write(data)
+			return err
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "json", fmt.Sprintf("Output format, one of %v", credentialsFormats))
+	cmd.Flags().StringVar(&profile, "profile", "default", "Name of the AWS profile or rclone remote for the aws-credentials, aws-config and rclone formats")
+	return cmd
+}
+
+func marshalIndent(info *cosiapi.BucketInfo) ([]byte, error) {
+	data, err := info.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	var out bytes.Buffer
+	if err := json.Indent(&out, data, "", "  "); err != nil {
+		return nil, err
+	}
+	out.WriteByte('\n')
+	return out.Bytes(), nil
+}
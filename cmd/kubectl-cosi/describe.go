@@ -0,0 +1,201 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeclientset "k8s.io/client-go/kubernetes"
+
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+	bucketclientset "sigs.k8s.io/container-object-storage-interface-api/client/clientset/versioned"
+)
+
+func newDescribeCommand(clientOpts *clientOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "describe",
+		Short: "Show details of COSI objects",
+	}
+	var allNamespaces bool
+	claimCmd := &cobra.Command{
+		Use:   "claim NAME",
+		Short: "Show a BucketClaim with its Bucket, BucketClass, BucketAccesses and their Secrets",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			namespace, err := clientOpts.namespace()
+			if err != nil {
+				return err
+			}
+			client, err := clientOpts.bucketClient()
+			if err != nil {
+				return err
+			}
+			kubeClient, err := clientOpts.kubeClient()
+			if err != nil {
+				return err
+			}
+			accessNamespace := namespace
+			if allNamespaces {
+				accessNamespace = metav1.NamespaceAll
+			}
+			return describeClaim(cmd.Context(), cmd.OutOrStdout(), client, kubeClient, namespace, args[0], accessNamespace)
+		},
+	}
+	claimCmd.Flags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, "Include BucketAccesses of all namespaces that use the claim through a BucketClaimGrant")
+	cmd.AddCommand(claimCmd)
+	return cmd
+}
+
+// describeClaim prints the claim called name in namespace along with the
+// BucketAccesses in accessNamespace that refer to it
+func describeClaim(ctx context.Context, out io.Writer, client bucketclientset.Interface, kubeClient kubeclientset.Interface, namespace, name, accessNamespace string) error {
+	claim, err := client.ObjectstorageV1alpha1().BucketClaims(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	bucket, err := getBucket(ctx, client, claim)
+	if err != nil {
+		return err
+	}
+	className := claim.Spec.BucketClassName
+	if bucket != nil && bucket.Spec.BucketClassName != "" {
+		className = bucket.Spec.BucketClassName
+	}
+	class, err := getBucketClass(ctx, client, className)
+	if err != nil {
+		return err
+	}
+	accesses, err := listBucketAccesses(ctx, client, accessNamespace)
+	if err != nil {
+		return err
+	}
+
+	w := &describer{w: tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)}
+	w.line(0, "BucketClaim", claim.Namespace+"/"+claim.Name)
+	w.line(1, "Class", orNone(claim.Spec.BucketClassName))
+	w.line(1, "Protocols", joinProtocols(claim.Spec.Protocols))
+	if claim.Spec.ExistingBucketName != "" {
+		w.line(1, "Existing Bucket", claim.Spec.ExistingBucketName)
+	}
+	w.line(1, "Status", readiness(claim.Status.Conditions, claim.Status.BucketReady))
+	w.conditions(1, claim.Status.Conditions)
+
+	if name := boundBucketName(claim); bucket == nil {
+		w.line(0, "Bucket", orNone(name)+" (not found)")
+	} else {
+		w.line(0, "Bucket", bucket.Name)
+		w.line(1, "Driver", bucket.Spec.DriverName)
+		bucketID := bucket.Status.BucketID
+		if bucketID == "" {
+			bucketID = bucket.Spec.ExistingBucketID
+		}
+		w.line(1, "Bucket ID", orNone(bucketID))
+		w.line(1, "Deletion Policy", string(bucket.Spec.DeletionPolicy))
+		w.line(1, "Protocols", joinProtocols(bucket.Spec.Protocols))
+		if ref := bucket.Spec.BucketClaim; ref != nil && (ref.Namespace != claim.Namespace || ref.Name != claim.Name) {
+			w.line(1, "Warning", fmt.Sprintf("bound to BucketClaim %s/%s", ref.Namespace, ref.Name))
+		}
+		w.line(1, "Status", readiness(bucket.Status.Conditions, bucket.Status.BucketReady))
+		w.conditions(1, bucket.Status.Conditions)
+	}
+
+	if class == nil {
+		w.line(0, "BucketClass", orNone(className)+" (not found)")
+	} else {
+		w.line(0, "BucketClass", class.Name)
+		w.line(1, "Driver", class.DriverName)
+		w.line(1, "Deletion Policy", string(class.DeletionPolicy))
+		w.line(1, "Parameters", formatMap(class.Parameters))
+	}
+
+	claimAccesses := accessesFor(accesses, claim)
+	if len(claimAccesses) == 0 {
+		w.line(0, "BucketAccesses", "<none>")
+	} else {
+		w.line(0, "BucketAccesses", "")
+	}
+	for _, access := range claimAccesses {
+		w.line(1, "BucketAccess", access.Namespace+"/"+access.Name)
+		w.line(2, "Access Class", access.Spec.BucketAccessClassName)
+		w.line(2, "Protocol", orNone(string(access.Spec.Protocol)))
+		w.line(2, "Service Account", orNone(access.Spec.ServiceAccountName))
+		w.line(2, "Account ID", orNone(access.Status.AccountID))
+		w.line(2, "Status", readiness(access.Status.Conditions, access.Status.AccessGranted))
+		w.line(2, "Secret", describeSecret(ctx, kubeClient, access))
+	}
+
+	return w.w.Flush()
+}
+
+// describeSecret returns the name of the credentials Secret of access and the
+// keys it holds, without revealing their values
+func describeSecret(ctx context.Context, kubeClient kubeclientset.Interface, access *v1alpha1.BucketAccess) string {
+	name := access.Namespace + "/" + access.Spec.CredentialsSecretName
+	secret, err := kubeClient.CoreV1().Secrets(access.Namespace).Get(ctx, access.Spec.CredentialsSecretName, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		return name + " (not found)"
+	case err != nil:
+		return fmt.Sprintf("%s (%v)", name, err)
+	}
+	keys := make([]string, 0, len(secret.Data))
+	for key := range secret.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return fmt.Sprintf("%s (keys: %s)", name, orNone(strings.Join(keys, ",")))
+}
+
+// describer writes indented label/value lines aligned in columns
+type describer struct {
+	w *tabwriter.Writer
+}
+
+func (d *describer) line(indent int, label, value string) {
+	fmt.Fprintf(d.w, "%s%s:\t%s\n", strings.Repeat("  ", indent), label, value)
+}
+
+func (d *describer) conditions(indent int, conditions []metav1.Condition) {
+	if len(conditions) == 0 {
+		return
+	}
+	d.line(indent, "Conditions", "")
+	for _, cond := range conditions {
+		value := fmt.Sprintf("%s %s", cond.Status, cond.Reason)
+		if cond.Message != "" {
+			value += ": " + cond.Message
+		}
+		d.line(indent+1, cond.Type, value)
+	}
+}
+
+func formatMap(m map[string]string) string {
+	pairs := make([]string, 0, len(m))
+	for key, value := range m {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return orNone(strings.Join(pairs, ","))
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+	"sigs.k8s.io/container-object-storage-interface-api/importer"
+)
+
+func newImportCommand(clientOpts *clientOptions) *cobra.Command {
+	opts := importer.Options{}
+	var protocols []string
+	var deletionPolicy string
+
+	cmd := &cobra.Command{
+		Use:   "import --driver DRIVER --bucket-id ID --claim NAME",
+		Short: "Import a bucket created outside of COSI",
+		Long: `Import a bucket created outside of COSI by creating a Bucket and a
+BucketClaim that are bound to each other. The BucketClaim is created in the
+namespace set with --namespace or by the current context. If the BucketClaim
+cannot be created, the Bucket is deleted again.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			namespace, err := clientOpts.namespace()
+			if err != nil {
+				return err
+			}
+			client, err := clientOpts.bucketClient()
+			if err != nil {
+				return err
+			}
+
+			opts.Namespace = namespace
+			opts.DeletionPolicy = v1alpha1.DeletionPolicy(deletionPolicy)
+			opts.Protocols = nil
+			for _, protocol := range protocols {
+				opts.Protocols = append(opts.Protocols, v1alpha1.Protocol(protocol))
+			}
+
+			bucket, claim, err := importer.Import(cmd.Context(), client, opts)
+			if err != nil {
+				return err
+			}
+
+			suffix := ""
+			if opts.DryRun {
+				suffix = " (server dry run)"
+			}
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "bucket.objectstorage.k8s.io/%s created%s\n", bucket.Name, suffix)
+			fmt.Fprintf(out, "bucketclaim.objectstorage.k8s.io/%s created in namespace %s%s\n", claim.Name, claim.Namespace, suffix)
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&opts.DriverName, "driver", "", "Name of the driver that manages the bucket")
+	flags.StringVar(&opts.BucketID, "bucket-id", "", "Unique id of the bucket in the object storage provider")
+	flags.StringVar(&opts.ClaimName, "claim", "", "Name of the BucketClaim to create")
+	flags.StringVar(&opts.BucketName, "bucket", "", "Name of the Bucket to create, defaults to <namespace>-<claim>")
+	flags.StringVar(&opts.BucketClassName, "class", "", "Name of the BucketClass to record on the Bucket and BucketClaim")
+	flags.StringSliceVar(&protocols, "protocol", nil, "Data APIs supported by the bucket: S3, Azure or GCP")
+	flags.StringVar(&deletionPolicy, "deletion-policy", string(v1alpha1.DeletionPolicyRetain), "Deletion policy of the Bucket: Retain or Delete")
+	flags.StringToStringVar(&opts.Parameters, "parameter", nil, "Parameters passed to the driver, as key=value")
+	flags.BoolVar(&opts.DryRun, "dry-run", false, "Submit the objects to the server without persisting them")
+	for _, name := range []string{"driver", "bucket-id", "claim", "protocol"} {
+		cobra.MarkFlagRequired(flags, name)
+	}
+	return cmd
+}
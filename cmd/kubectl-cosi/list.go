@@ -0,0 +1,193 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+	bucketclientset "sigs.k8s.io/container-object-storage-interface-api/client/clientset/versioned"
+)
+
+// listKinds are the kinds accepted by the list command, in the order they are
+// listed when no kind is given
+var listKinds = []string{"claims", "buckets", "accesses"}
+
+func newListCommand(clientOpts *clientOptions) *cobra.Command {
+	var allNamespaces, unbound bool
+
+	cmd := &cobra.Command{
+		Use:   "list [claims|buckets|accesses]",
+		Short: "List BucketClaims, Buckets and BucketAccesses",
+		Long: `List BucketClaims, Buckets and BucketAccesses. With --unbound, only
+BucketClaims without a Bucket, Buckets that no BucketClaim is bound to, and
+BucketAccesses that were not granted are listed.`,
+		Args:      cobra.MaximumNArgs(1),
+		ValidArgs: listKinds,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kinds := listKinds
+			if len(args) == 1 {
+				if !containsString(listKinds, args[0]) {
+					return fmt.Errorf("unknown kind %q, must be one of %v", args[0], listKinds)
+				}
+				kinds = args
+			}
+
+			namespace, err := clientOpts.namespace()
+			if err != nil {
+				return err
+			}
+			if allNamespaces {
+				namespace = metav1.NamespaceAll
+			}
+			client, err := clientOpts.bucketClient()
+			if err != nil {
+				return err
+			}
+
+			l := &lister{
+				client:    client,
+				out:       tabwriter.NewWriter(cmd.OutOrStdout(), 0, 8, 3, ' ', 0),
+				namespace: namespace,
+				unbound:   unbound,
+			}
+			for i, kind := range kinds {
+				if i > 0 {
+					fmt.Fprintln(l.out)
+				}
+				if err := l.list(cmd.Context(), kind); err != nil {
+					return err
+				}
+			}
+			return l.out.Flush()
+		},
+	}
+	cmd.Flags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, "List BucketClaims and BucketAccesses of all namespaces")
+	cmd.Flags().BoolVar(&unbound, "unbound", false, "Only list objects that are not bound or granted")
+	return cmd
+}
+
+type lister struct {
+	client    bucketclientset.Interface
+	out       *tabwriter.Writer
+	namespace string
+	unbound   bool
+}
+
+func (l *lister) list(ctx context.Context, kind string) error {
+	switch kind {
+	case "claims":
+		return l.listClaims(ctx)
+	case "buckets":
+		return l.listBuckets(ctx)
+	default:
+		return l.listAccesses(ctx)
+	}
+}
+
+func (l *lister) listClaims(ctx context.Context) error {
+	claims, err := l.client.ObjectstorageV1alpha1().BucketClaims(l.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list BucketClaims: %w", err)
+	}
+
+	fmt.Fprintln(l.out, "NAMESPACE\tBUCKETCLAIM\tCLASS\tBUCKET\tSTATUS")
+	for i := range claims.Items {
+		claim := &claims.Items[i]
+		if l.unbound && claim.Status.BucketName != "" {
+			continue
+		}
+		fmt.Fprintf(l.out, "%s\t%s\t%s\t%s\t%s\n", claim.Namespace, claim.Name, orNone(claim.Spec.BucketClassName),
+			orNone(boundBucketName(claim)), readiness(claim.Status.Conditions, claim.Status.BucketReady))
+	}
+	return nil
+}
+
+// listBuckets lists cluster scoped Buckets. A Bucket is unbound if it does not
+// refer to a BucketClaim, or the BucketClaim it refers to is missing or bound
+// to another Bucket.
+func (l *lister) listBuckets(ctx context.Context) error {
+	buckets, err := l.client.ObjectstorageV1alpha1().Buckets().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list Buckets: %w", err)
+	}
+	var claims map[string]*v1alpha1.BucketClaim
+	if l.unbound {
+		list, err := l.client.ObjectstorageV1alpha1().BucketClaims(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to list BucketClaims: %w", err)
+		}
+		claims = map[string]*v1alpha1.BucketClaim{}
+		for i := range list.Items {
+			claim := &list.Items[i]
+			claims[claim.Namespace+"/"+claim.Name] = claim
+		}
+	}
+
+	fmt.Fprintln(l.out, "BUCKET\tDRIVER\tCLASS\tBUCKETCLAIM\tSTATUS")
+	for i := range buckets.Items {
+		bucket := &buckets.Items[i]
+		claimName := ""
+		if ref := bucket.Spec.BucketClaim; ref != nil && ref.Name != "" {
+			claimName = ref.Namespace + "/" + ref.Name
+		}
+		if l.unbound && claimName != "" {
+			if claim, ok := claims[claimName]; ok && claim.Status.BucketName == bucket.Name {
+				continue
+			}
+		}
+		fmt.Fprintf(l.out, "%s\t%s\t%s\t%s\t%s\n", bucket.Name, bucket.Spec.DriverName, orNone(bucket.Spec.BucketClassName),
+			orNone(claimName), readiness(bucket.Status.Conditions, bucket.Status.BucketReady))
+	}
+	return nil
+}
+
+func (l *lister) listAccesses(ctx context.Context) error {
+	accesses, err := l.client.ObjectstorageV1alpha1().BucketAccesses(l.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list BucketAccesses: %w", err)
+	}
+
+	fmt.Fprintln(l.out, "NAMESPACE\tBUCKETACCESS\tCLASS\tBUCKETCLAIM\tSECRET\tSTATUS")
+	for i := range accesses.Items {
+		access := &accesses.Items[i]
+		if l.unbound && access.Status.AccessGranted {
+			continue
+		}
+		claimName := access.Spec.BucketClaimName
+		if access.Spec.BucketClaimNamespace != "" && access.Spec.BucketClaimNamespace != access.Namespace {
+			claimName = access.Spec.BucketClaimNamespace + "/" + claimName
+		}
+		fmt.Fprintf(l.out, "%s\t%s\t%s\t%s\t%s\t%s\n", access.Namespace, access.Name, access.Spec.BucketAccessClassName,
+			claimName, access.Spec.CredentialsSecretName, readiness(access.Status.Conditions, access.Status.AccessGranted))
+	}
+	return nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+	bucketclientset "sigs.k8s.io/container-object-storage-interface-api/client/clientset/versioned"
+	"sigs.k8s.io/container-object-storage-interface-api/controller"
+)
+
+// boundBucketName returns the name of the Bucket that claim is, or is to be, bound to
+func boundBucketName(claim *v1alpha1.BucketClaim) string {
+	if claim.Status.BucketName != "" {
+		return claim.Status.BucketName
+	}
+	return claim.Spec.ExistingBucketName
+}
+
+// getBucket returns the Bucket that claim is bound to, or nil if there is none
+func getBucket(ctx context.Context, client bucketclientset.Interface, claim *v1alpha1.BucketClaim) (*v1alpha1.Bucket, error) {
+	name := boundBucketName(claim)
+	if name == "" {
+		return nil, nil
+	}
+	bucket, err := client.ObjectstorageV1alpha1().Buckets().Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return bucket, err
+}
+
+// getBucketClass returns the named BucketClass, or nil if it does not exist
+func getBucketClass(ctx context.Context, client bucketclientset.Interface, name string) (*v1alpha1.BucketClass, error) {
+	if name == "" {
+		return nil, nil
+	}
+	class, err := client.ObjectstorageV1alpha1().BucketClasses().Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return class, err
+}
+
+// accessesFor returns the BucketAccesses in accesses that refer to claim,
+// including those in other namespaces
+func accessesFor(accesses []v1alpha1.BucketAccess, claim *v1alpha1.BucketClaim) []*v1alpha1.BucketAccess {
+	var result []*v1alpha1.BucketAccess
+	for i := range accesses {
+		access := &accesses[i]
+		if access.Spec.BucketClaimName == claim.Name && controller.BucketClaimNamespace(access) == claim.Namespace {
+			result = append(result, access)
+		}
+	}
+	return result
+}
+
+// listBucketAccesses lists the BucketAccesses in namespace. A claim may be
+// referred to from other namespaces through a BucketClaimGrant, and those
+// BucketAccesses are only found if namespace is metav1.NamespaceAll.
+func listBucketAccesses(ctx context.Context, client bucketclientset.Interface, namespace string) ([]v1alpha1.BucketAccess, error) {
+	list, err := client.ObjectstorageV1alpha1().BucketAccesses(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list BucketAccesses: %w", err)
+	}
+	return list.Items, nil
+}
+
+// readiness summarizes the Ready condition of an object. Objects written by
+// controllers that do not set conditions fall back to their boolean status.
+func readiness(conditions []metav1.Condition, ready bool) string {
+	if cond := meta.FindStatusCondition(conditions, v1alpha1.ConditionReady); cond != nil {
+		if cond.Status == metav1.ConditionTrue {
+			return "Ready"
+		}
+		if cond.Reason != "" {
+			return fmt.Sprintf("NotReady (%s)", cond.Reason)
+		}
+		return "NotReady"
+	}
+	if ready {
+		return "Ready"
+	}
+	return "NotReady"
+}
+
+func joinProtocols(protocols []v1alpha1.Protocol) string {
+	names := make([]string, 0, len(protocols))
+	for _, p := range protocols {
+		names = append(names, string(p))
+	}
+	return strings.Join(names, ",")
+}
+
+// orNone returns value, or <none> if it is empty
+func orNone(value string) string {
+	if value == "" {
+		return "<none>"
+	}
+	return value
+}
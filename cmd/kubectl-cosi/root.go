@@ -0,0 +1,83 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+	kubeclientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	bucketclientset "sigs.k8s.io/container-object-storage-interface-api/client/clientset/versioned"
+)
+
+// clientOptions holds the kubeconfig flags shared by all subcommands
+type clientOptions struct {
+	loadingRules *clientcmd.ClientConfigLoadingRules
+	overrides    *clientcmd.ConfigOverrides
+}
+
+func (o *clientOptions) clientConfig() clientcmd.ClientConfig {
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(o.loadingRules, o.overrides)
+}
+
+// bucketClient returns a client for the objectstorage API
+func (o *clientOptions) bucketClient() (bucketclientset.Interface, error) {
+	cfg, err := o.clientConfig().ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	return bucketclientset.NewForConfig(cfg)
+}
+
+// kubeClient returns a client for the core API, used to read Secrets
+func (o *clientOptions) kubeClient() (kubeclientset.Interface, error) {
+	cfg, err := o.clientConfig().ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	return kubeclientset.NewForConfig(cfg)
+}
+
+// namespace returns the namespace set with --namespace or by the current context
+func (o *clientOptions) namespace() (string, error) {
+	namespace, _, err := o.clientConfig().Namespace()
+	return namespace, err
+}
+
+func newRootCommand() *cobra.Command {
+	opts := &clientOptions{
+		loadingRules: clientcmd.NewDefaultClientConfigLoadingRules(),
+		overrides:    &clientcmd.ConfigOverrides{},
+	}
+
+	cmd := &cobra.Command{
+		Use:          "kubectl-cosi",
+		Short:        "Manage Container Object Storage Interface objects",
+		SilenceUsage: true,
+	}
+	cmd.PersistentFlags().StringVar(&opts.loadingRules.ExplicitPath, "kubeconfig", "", "Path to the kubeconfig file")
+	clientcmd.BindOverrideFlags(opts.overrides, cmd.PersistentFlags(), clientcmd.RecommendedConfigOverrideFlags(""))
+
+	cmd.AddCommand(
+		newDescribeCommand(opts),
+		newTreeCommand(opts),
+		newListCommand(opts),
+		newCredentialsCommand(opts),
+		newImportCommand(opts),
+	)
+	return cmd
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeclientset "k8s.io/client-go/kubernetes"
+
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+	bucketclientset "sigs.k8s.io/container-object-storage-interface-api/client/clientset/versioned"
+)
+
+func newTreeCommand(clientOpts *clientOptions) *cobra.Command {
+	var allNamespaces bool
+
+	cmd := &cobra.Command{
+		Use:   "tree [CLAIM]",
+		Short: "Show BucketClaims with their Bucket, BucketClass, BucketAccesses and Secrets as a tree",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			namespace, err := clientOpts.namespace()
+			if err != nil {
+				return err
+			}
+			if allNamespaces {
+				namespace = metav1.NamespaceAll
+			}
+			client, err := clientOpts.bucketClient()
+			if err != nil {
+				return err
+			}
+			kubeClient, err := clientOpts.kubeClient()
+			if err != nil {
+				return err
+			}
+
+			var claims []v1alpha1.BucketClaim
+			if len(args) == 1 {
+				claim, err := client.ObjectstorageV1alpha1().BucketClaims(namespace).Get(cmd.Context(), args[0], metav1.GetOptions{})
+				if err != nil {
+					return err
+				}
+				claims = append(claims, *claim)
+			} else {
+				list, err := client.ObjectstorageV1alpha1().BucketClaims(namespace).List(cmd.Context(), metav1.ListOptions{})
+				if err != nil {
+					return err
+				}
+				claims = list.Items
+			}
+			return printTree(cmd.Context(), cmd.OutOrStdout(), client, kubeClient, claims, namespace)
+		},
+	}
+	cmd.Flags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, "Show BucketClaims of all namespaces, and BucketAccesses that use them through a BucketClaimGrant")
+	return cmd
+}
+
+// treeNode is a line of the tree and the lines nested below it
+type treeNode struct {
+	label    string
+	children []*treeNode
+}
+
+func (n *treeNode) add(format string, args ...interface{}) *treeNode {
+	child := &treeNode{label: fmt.Sprintf(format, args...)}
+	n.children = append(n.children, child)
+	return child
+}
+
+func (n *treeNode) print(out io.Writer, prefix string) {
+	for i, child := range n.children {
+		branch, indent := "├── ", "│   "
+		if i == len(n.children)-1 {
+			branch, indent = "└── ", "    "
+		}
+		fmt.Fprintf(out, "%s%s%s\n", prefix, branch, child.label)
+		child.print(out, prefix+indent)
+	}
+}
+
+// printTree prints claims along with the BucketAccesses in accessNamespace
+// that refer to them
+func printTree(ctx context.Context, out io.Writer, client bucketclientset.Interface, kubeClient kubeclientset.Interface, claims []v1alpha1.BucketClaim, accessNamespace string) error {
+	if len(claims) == 0 {
+		fmt.Fprintln(out, "No BucketClaims found")
+		return nil
+	}
+
+	buckets, err := client.ObjectstorageV1alpha1().Buckets().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list Buckets: %w", err)
+	}
+	bucketsByName := map[string]*v1alpha1.Bucket{}
+	for i := range buckets.Items {
+		bucketsByName[buckets.Items[i].Name] = &buckets.Items[i]
+	}
+	classes, err := client.ObjectstorageV1alpha1().BucketClasses().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list BucketClasses: %w", err)
+	}
+	classesByName := map[string]*v1alpha1.BucketClass{}
+	for i := range classes.Items {
+		classesByName[classes.Items[i].Name] = &classes.Items[i]
+	}
+	accesses, err := listBucketAccesses(ctx, client, accessNamespace)
+	if err != nil {
+		return err
+	}
+
+	for i := range claims {
+		claim := &claims[i]
+		claimNode := &treeNode{label: fmt.Sprintf("BucketClaim %s/%s  %s", claim.Namespace, claim.Name, readiness(claim.Status.Conditions, claim.Status.BucketReady))}
+
+		className := claim.Spec.BucketClassName
+		if name := boundBucketName(claim); name == "" {
+			claimNode.add("Bucket <none>")
+		} else if bucket, ok := bucketsByName[name]; !ok {
+			claimNode.add("Bucket %s  (not found)", name)
+		} else {
+			bucketNode := claimNode.add("Bucket %s  %s", bucket.Name, readiness(bucket.Status.Conditions, bucket.Status.BucketReady))
+			if bucket.Spec.BucketClassName != "" {
+				className = bucket.Spec.BucketClassName
+			}
+			if className != "" {
+				if _, ok := classesByName[className]; ok {
+					bucketNode.add("BucketClass %s", className)
+				} else {
+					bucketNode.add("BucketClass %s  (not found)", className)
+				}
+			}
+		}
+
+		for _, access := range accessesFor(accesses, claim) {
+			accessNode := claimNode.add("BucketAccess %s/%s  %s", access.Namespace, access.Name, readiness(access.Status.Conditions, access.Status.AccessGranted))
+			_, err := kubeClient.CoreV1().Secrets(access.Namespace).Get(ctx, access.Spec.CredentialsSecretName, metav1.GetOptions{})
+			switch {
+			case apierrors.IsNotFound(err):
+				accessNode.add("Secret %s/%s  (not found)", access.Namespace, access.Spec.CredentialsSecretName)
+			case err != nil:
+				accessNode.add("Secret %s/%s  (%v)", access.Namespace, access.Spec.CredentialsSecretName, err)
+			default:
+				accessNode.add("Secret %s/%s", access.Namespace, access.Spec.CredentialsSecretName)
+			}
+		}
+
+		fmt.Fprintln(out, claimNode.label)
+		claimNode.print(out, "")
+	}
+	return nil
+}
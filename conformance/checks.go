@@ -0,0 +1,313 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+
+	cosi "sigs.k8s.io/container-object-storage-interface-spec"
+)
+
+// RPCs checked by the suite
+const (
+	RPCGetInfo            = "ProvisionerGetInfo"
+	RPCCreateBucket       = "ProvisionerCreateBucket"
+	RPCDeleteBucket       = "ProvisionerDeleteBucket"
+	RPCGrantBucketAccess  = "ProvisionerGrantBucketAccess"
+	RPCRevokeBucketAccess = "ProvisionerRevokeBucketAccess"
+)
+
+var checks = []Check{
+	{
+		Name:        "get-info-name",
+		RPC:         RPCGetInfo,
+		Description: "ProvisionerGetInfo returns the name of the driver",
+		run: func(ctx context.Context, e *env) error {
+			rpcCtx, cancel := e.rpcContext(ctx)
+			defer cancel()
+			info, err := e.identity.ProvisionerGetInfo(rpcCtx, &cosi.ProvisionerGetInfoRequest{})
+			if err != nil {
+				return err
+			}
+			if info.Name == "" {
+				return fmt.Errorf("name is empty")
+			}
+			return nil
+		},
+	},
+	{
+		Name:        "create-bucket",
+		RPC:         RPCCreateBucket,
+		Description: "ProvisionerCreateBucket returns the id of the new bucket",
+		run: func(ctx context.Context, e *env) error {
+			bucketID, err := e.setupBucket(ctx)
+			if err != nil {
+				return err
+			}
+			if bucketID == "" {
+				return fmt.Errorf("bucket_id is empty")
+			}
+			return nil
+		},
+	},
+	{
+		Name:        "create-bucket-idempotent",
+		RPC:         RPCCreateBucket,
+		Description: "ProvisionerCreateBucket returns OK and the same bucket_id for a bucket that exists with the same name and parameters",
+		run: func(ctx context.Context, e *env) error {
+			name := e.name()
+			first, err := e.createBucket(ctx, name, e.cfg.Parameters)
+			if err != nil {
+				return fmt.Errorf("setup: failed to create bucket: %w", err)
+			}
+			e.cleanups = append(e.cleanups, func(ctx context.Context) error {
+				return e.deleteBucket(ctx, first.BucketId)
+			})
+
+			second, err := e.createBucket(ctx, name, e.cfg.Parameters)
+			if err != nil {
+				return fmt.Errorf("second call failed: %w", err)
+			}
+			if second.BucketId != first.BucketId {
+				return fmt.Errorf("second call returned bucket_id %q, first returned %q", second.BucketId, first.BucketId)
+			}
+			return nil
+		},
+	},
+	{
+		Name:        "create-bucket-incompatible",
+		RPC:         RPCCreateBucket,
+		Description: "ProvisionerCreateBucket returns ALREADY_EXISTS for a bucket that exists with the same name and different parameters",
+		run: func(ctx context.Context, e *env) error {
+			if e.cfg.IncompatibleParameters == nil {
+				return &errSkipped{reason: "no incompatible parameters configured"}
+			}
+			name := e.name()
+			first, err := e.createBucket(ctx, name, e.cfg.Parameters)
+			if err != nil {
+				return fmt.Errorf("setup: failed to create bucket: %w", err)
+			}
+			e.cleanups = append(e.cleanups, func(ctx context.Context) error {
+				return e.deleteBucket(ctx, first.BucketId)
+			})
+
+			second, err := e.createBucket(ctx, name, e.cfg.IncompatibleParameters)
+			if err == nil && second.BucketId != first.BucketId {
+				// do not leak a second bucket created by a non-conforming driver
+				e.cleanups = append(e.cleanups, func(ctx context.Context) error {
+					return e.deleteBucket(ctx, second.BucketId)
+				})
+			}
+			return expectCode(err, codes.AlreadyExists)
+		},
+	},
+	{
+		Name:        "create-bucket-missing-name",
+		RPC:         RPCCreateBucket,
+		Description: "ProvisionerCreateBucket returns INVALID_ARGUMENT if the name is missing",
+		run: func(ctx context.Context, e *env) error {
+			resp, err := e.createBucket(ctx, "", e.cfg.Parameters)
+			if err == nil {
+				e.cleanups = append(e.cleanups, func(ctx context.Context) error {
+					return e.deleteBucket(ctx, resp.BucketId)
+				})
+			}
+			return expectCode(err, codes.InvalidArgument)
+		},
+	},
+	{
+		Name:        "delete-bucket",
+		RPC:         RPCDeleteBucket,
+		Description: "ProvisionerDeleteBucket deletes an existing bucket",
+		run: func(ctx context.Context, e *env) error {
+			resp, err := e.createBucket(ctx, e.name(), e.cfg.Parameters)
+			if err != nil {
+				return fmt.Errorf("setup: failed to create bucket: %w", err)
+			}
+			return e.deleteBucket(ctx, resp.BucketId)
+		},
+	},
+	{
+		Name:        "delete-bucket-idempotent",
+		RPC:         RPCDeleteBucket,
+		Description: "ProvisionerDeleteBucket returns OK for a bucket that was already deleted",
+		run: func(ctx context.Context, e *env) error {
+			resp, err := e.createBucket(ctx, e.name(), e.cfg.Parameters)
+			if err != nil {
+				return fmt.Errorf("setup: failed to create bucket: %w", err)
+			}
+			if err := e.deleteBucket(ctx, resp.BucketId); err != nil {
+				return fmt.Errorf("setup: failed to delete bucket: %w", err)
+			}
+			if err := e.deleteBucket(ctx, resp.BucketId); err != nil {
+				return fmt.Errorf("second call failed: %w", err)
+			}
+			return nil
+		},
+	},
+	{
+		Name:        "delete-bucket-missing-id",
+		RPC:         RPCDeleteBucket,
+		Description: "ProvisionerDeleteBucket returns INVALID_ARGUMENT if the bucket_id is missing",
+		run: func(ctx context.Context, e *env) error {
+			return expectCode(e.deleteBucket(ctx, ""), codes.InvalidArgument)
+		},
+	},
+	{
+		Name:        "grant-access",
+		RPC:         RPCGrantBucketAccess,
+		Description: "ProvisionerGrantBucketAccess returns an account_id and credentials",
+		run: func(ctx context.Context, e *env) error {
+			bucketID, err := e.setupBucket(ctx)
+			if err != nil {
+				return err
+			}
+			resp, err := e.setupAccess(ctx, bucketID)
+			if err != nil {
+				return err
+			}
+			if resp.AccountId == "" {
+				return fmt.Errorf("account_id is empty")
+			}
+			if resp.Credentials == "" {
+				return fmt.Errorf("credentials are empty")
+			}
+			return nil
+		},
+	},
+	{
+		Name:        "grant-access-idempotent",
+		RPC:         RPCGrantBucketAccess,
+		Description: "ProvisionerGrantBucketAccess returns the same account_id and credentials when called again for an account_name",
+		run: func(ctx context.Context, e *env) error {
+			bucketID, err := e.setupBucket(ctx)
+			if err != nil {
+				return err
+			}
+			accountName := e.name()
+			first, err := e.grantAccess(ctx, bucketID, accountName)
+			if err != nil {
+				return fmt.Errorf("setup: failed to grant access: %w", err)
+			}
+			e.cleanups = append(e.cleanups, func(ctx context.Context) error {
+				return e.revokeAccess(ctx, bucketID, first.AccountId)
+			})
+
+			second, err := e.grantAccess(ctx, bucketID, accountName)
+			if err != nil {
+				return fmt.Errorf("second call failed: %w", err)
+			}
+			if second.AccountId != first.AccountId {
+				e.cleanups = append(e.cleanups, func(ctx context.Context) error {
+					return e.revokeAccess(ctx, bucketID, second.AccountId)
+				})
+				return fmt.Errorf("second call returned account_id %q, first returned %q", second.AccountId, first.AccountId)
+			}
+			if second.Credentials != first.Credentials {
+				return fmt.Errorf("second call minted new credentials for account %q", first.AccountId)
+			}
+			return nil
+		},
+	},
+	{
+		Name:        "grant-access-missing-bucket",
+		RPC:         RPCGrantBucketAccess,
+		Description: "ProvisionerGrantBucketAccess returns NOT_FOUND for a bucket that does not exist",
+		run: func(ctx context.Context, e *env) error {
+			// a fresh name is the id of a bucket that was never created
+			bucketID := e.name()
+			resp, err := e.grantAccess(ctx, bucketID, e.name())
+			if err == nil {
+				e.cleanups = append(e.cleanups, func(ctx context.Context) error {
+					return e.revokeAccess(ctx, bucketID, resp.AccountId)
+				})
+			}
+			return expectCode(err, codes.NotFound)
+		},
+	},
+	{
+		Name:        "grant-access-missing-account-name",
+		RPC:         RPCGrantBucketAccess,
+		Description: "ProvisionerGrantBucketAccess returns INVALID_ARGUMENT if the account_name is missing",
+		run: func(ctx context.Context, e *env) error {
+			bucketID, err := e.setupBucket(ctx)
+			if err != nil {
+				return err
+			}
+			resp, err := e.grantAccess(ctx, bucketID, "")
+			if err == nil {
+				e.cleanups = append(e.cleanups, func(ctx context.Context) error {
+					return e.revokeAccess(ctx, bucketID, resp.AccountId)
+				})
+			}
+			return expectCode(err, codes.InvalidArgument)
+		},
+	},
+	{
+		Name:        "revoke-access",
+		RPC:         RPCRevokeBucketAccess,
+		Description: "ProvisionerRevokeBucketAccess revokes granted access",
+		run: func(ctx context.Context, e *env) error {
+			bucketID, err := e.setupBucket(ctx)
+			if err != nil {
+				return err
+			}
+			resp, err := e.grantAccess(ctx, bucketID, e.name())
+			if err != nil {
+				return fmt.Errorf("setup: failed to grant access: %w", err)
+			}
+			return e.revokeAccess(ctx, bucketID, resp.AccountId)
+		},
+	},
+	{
+		Name:        "revoke-access-idempotent",
+		RPC:         RPCRevokeBucketAccess,
+		Description: "ProvisionerRevokeBucketAccess returns OK for access that was already revoked",
+		run: func(ctx context.Context, e *env) error {
+			bucketID, err := e.setupBucket(ctx)
+			if err != nil {
+				return err
+			}
+			resp, err := e.grantAccess(ctx, bucketID, e.name())
+			if err != nil {
+				return fmt.Errorf("setup: failed to grant access: %w", err)
+			}
+			if err := e.revokeAccess(ctx, bucketID, resp.AccountId); err != nil {
+				return fmt.Errorf("setup: failed to revoke access: %w", err)
+			}
+			if err := e.revokeAccess(ctx, bucketID, resp.AccountId); err != nil {
+				return fmt.Errorf("second call failed: %w", err)
+			}
+			return nil
+		},
+	},
+	{
+		Name:        "revoke-access-missing-account-id",
+		RPC:         RPCRevokeBucketAccess,
+		Description: "ProvisionerRevokeBucketAccess returns INVALID_ARGUMENT if the account_id is missing",
+		run: func(ctx context.Context, e *env) error {
+			bucketID, err := e.setupBucket(ctx)
+			if err != nil {
+				return err
+			}
+			return expectCode(e.revokeAccess(ctx, bucketID, ""), codes.InvalidArgument)
+		},
+	},
+}
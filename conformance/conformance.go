@@ -0,0 +1,284 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conformance checks that a COSI driver implements the Identity and
+// Provisioner services as required by the COSI spec. The checks create and
+// delete real buckets in the object store of the driver, and clean up after
+// themselves.
+package conformance
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	cosi "sigs.k8s.io/container-object-storage-interface-spec"
+)
+
+// defaultTimeout is the timeout of each RPC if Config does not set one
+const defaultTimeout = 30 * time.Second
+
+// Config configures a conformance run
+type Config struct {
+	// Protocol is the protocol of the buckets created by the checks.
+	// Defaults to S3.
+	Protocol *cosi.Protocol
+
+	// Parameters are passed to the driver when creating buckets
+	Parameters map[string]string
+
+	// IncompatibleParameters are parameters that the driver must consider
+	// incompatible with Parameters for a bucket of the same name. The check
+	// for AlreadyExists is skipped if they are not set, as the meaning of
+	// parameters is specific to each driver.
+	IncompatibleParameters map[string]string
+
+	// AccessParameters are passed to the driver when granting access
+	AccessParameters map[string]string
+
+	// AccessPolicy is passed to the driver when granting access
+	AccessPolicy string
+
+	// NamePrefix is the prefix of the names of the buckets and accounts
+	// created by the checks. Defaults to cosi-conformance.
+	NamePrefix string
+
+	// Timeout is the timeout of each RPC. Defaults to 30 seconds.
+	Timeout time.Duration
+
+	// Focus restricts the run to the checks whose name matches it
+	Focus *regexp.Regexp
+}
+
+// Check is a requirement of the spec on one RPC
+type Check struct {
+	// Name identifies the check, e.g. create-bucket-idempotent
+	Name string
+
+	// RPC is the RPC under test
+	RPC string
+
+	// Description is the requirement of the spec that is checked
+	Description string
+
+	run func(ctx context.Context, e *env) error
+}
+
+// Checks returns the checks run by Run, in order
+func Checks() []Check {
+	return append([]Check{}, checks...)
+}
+
+// Run runs the checks against the driver served on conn, and returns a report
+// of their results. A failing check does not stop the run. Once ctx is done, no
+// more checks are started, and the report only holds the checks that ran. An
+// error is only returned if the driver cannot be identified.
+func Run(ctx context.Context, conn grpc.ClientConnInterface, cfg Config) (*Report, error) {
+	cfg = withDefaults(cfg)
+	identity := cosi.NewIdentityClient(conn)
+	provisioner := cosi.NewProvisionerClient(conn)
+
+	infoCtx, cancel := context.WithTimeout(ctx, cfg.Timeout)
+	info, err := identity.ProvisionerGetInfo(infoCtx, &cosi.ProvisionerGetInfoRequest{})
+	cancel()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get driver info: %w", err)
+	}
+
+	report := &Report{
+		Driver:    info.Name,
+		StartTime: time.Now(),
+	}
+	for _, check := range checks {
+		if ctx.Err() != nil {
+			break
+		}
+		if cfg.Focus != nil && !cfg.Focus.MatchString(check.Name) {
+			continue
+		}
+		e := &env{
+			cfg:         cfg,
+			identity:    identity,
+			provisioner: provisioner,
+			check:       check.Name,
+		}
+		report.Results = append(report.Results, e.run(ctx, check))
+	}
+	report.Duration = time.Since(report.StartTime)
+	return report, nil
+}
+
+func withDefaults(cfg Config) Config {
+	if cfg.Protocol == nil {
+		cfg.Protocol = &cosi.Protocol{Type: &cosi.Protocol_S3{S3: &cosi.S3{}}}
+	}
+	if cfg.NamePrefix == "" {
+		cfg.NamePrefix = "cosi-conformance"
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = defaultTimeout
+	}
+	return cfg
+}
+
+// errSkipped is returned by checks that do not apply to the configuration
+type errSkipped struct {
+	reason string
+}
+
+func (e *errSkipped) Error() string {
+	return e.reason
+}
+
+// env is the state of a single check. Buckets and accounts created through it
+// are removed when the check is done.
+type env struct {
+	cfg         Config
+	identity    cosi.IdentityClient
+	provisioner cosi.ProvisionerClient
+	check       string
+	cleanups    []func(ctx context.Context) error
+}
+
+func (e *env) run(ctx context.Context, check Check) Result {
+	result := Result{
+		Name:        check.Name,
+		RPC:         check.RPC,
+		Description: check.Description,
+		Status:      StatusPassed,
+	}
+	start := time.Now()
+	err := check.run(ctx, e)
+	result.Duration = time.Since(start)
+
+	if skipped, ok := err.(*errSkipped); ok {
+		result.Status = StatusSkipped
+		result.Message = skipped.reason
+	} else if err != nil {
+		result.Status = StatusFailed
+		result.Message = err.Error()
+	}
+
+	// cleanup failures fail the check, as the driver left objects behind.
+	// Cleanups do not use ctx, so that they also run when the check was
+	// interrupted.
+	for i := len(e.cleanups) - 1; i >= 0; i-- {
+		if err := e.cleanup(e.cleanups[i]); err != nil && result.Status != StatusFailed {
+			result.Status = StatusFailed
+			result.Message = fmt.Sprintf("cleanup: %v", err)
+		}
+	}
+	return result
+}
+
+// cleanup runs a cleanup function with a context of its own
+func (e *env) cleanup(cleanup func(ctx context.Context) error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), e.cfg.Timeout)
+	defer cancel()
+	return cleanup(ctx)
+}
+
+// name returns a unique name for a bucket or account of the check
+func (e *env) name() string {
+	suffix := make([]byte, 4)
+	rand.Read(suffix)
+	return fmt.Sprintf("%s-%s-%s", e.cfg.NamePrefix, e.check, hex.EncodeToString(suffix))
+}
+
+// rpcContext returns the context for a single RPC
+func (e *env) rpcContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, e.cfg.Timeout)
+}
+
+func (e *env) createBucket(ctx context.Context, name string, parameters map[string]string) (*cosi.ProvisionerCreateBucketResponse, error) {
+	rpcCtx, cancel := e.rpcContext(ctx)
+	defer cancel()
+	return e.provisioner.ProvisionerCreateBucket(rpcCtx, &cosi.ProvisionerCreateBucketRequest{
+		Name:       name,
+		Protocol:   e.cfg.Protocol,
+		Parameters: parameters,
+	})
+}
+
+func (e *env) deleteBucket(ctx context.Context, bucketID string) error {
+	rpcCtx, cancel := e.rpcContext(ctx)
+	defer cancel()
+	_, err := e.provisioner.ProvisionerDeleteBucket(rpcCtx, &cosi.ProvisionerDeleteBucketRequest{BucketId: bucketID})
+	return err
+}
+
+func (e *env) grantAccess(ctx context.Context, bucketID, accountName string) (*cosi.ProvisionerGrantBucketAccessResponse, error) {
+	rpcCtx, cancel := e.rpcContext(ctx)
+	defer cancel()
+	return e.provisioner.ProvisionerGrantBucketAccess(rpcCtx, &cosi.ProvisionerGrantBucketAccessRequest{
+		BucketId:     bucketID,
+		AccountName:  accountName,
+		AccessPolicy: e.cfg.AccessPolicy,
+		Parameters:   e.cfg.AccessParameters,
+	})
+}
+
+func (e *env) revokeAccess(ctx context.Context, bucketID, accountID string) error {
+	rpcCtx, cancel := e.rpcContext(ctx)
+	defer cancel()
+	_, err := e.provisioner.ProvisionerRevokeBucketAccess(rpcCtx, &cosi.ProvisionerRevokeBucketAccessRequest{
+		BucketId:  bucketID,
+		AccountId: accountID,
+	})
+	return err
+}
+
+// setupBucket creates a bucket that is deleted when the check is done
+func (e *env) setupBucket(ctx context.Context) (string, error) {
+	resp, err := e.createBucket(ctx, e.name(), e.cfg.Parameters)
+	if err != nil {
+		return "", fmt.Errorf("setup: failed to create bucket: %w", err)
+	}
+	e.cleanups = append(e.cleanups, func(ctx context.Context) error {
+		return e.deleteBucket(ctx, resp.BucketId)
+	})
+	return resp.BucketId, nil
+}
+
+// setupAccess grants access to bucketID, and revokes it when the check is done
+func (e *env) setupAccess(ctx context.Context, bucketID string) (*cosi.ProvisionerGrantBucketAccessResponse, error) {
+	resp, err := e.grantAccess(ctx, bucketID, e.name())
+	if err != nil {
+		return nil, fmt.Errorf("setup: failed to grant access: %w", err)
+	}
+	e.cleanups = append(e.cleanups, func(ctx context.Context) error {
+		return e.revokeAccess(ctx, bucketID, resp.AccountId)
+	})
+	return resp, nil
+}
+
+// expectCode returns an error unless err has the gRPC code want
+func expectCode(err error, want codes.Code) error {
+	if got := status.Code(err); got != want {
+		if err == nil {
+			return fmt.Errorf("expected code %s, got success", want)
+		}
+		return fmt.Errorf("expected code %s, got %s: %s", want, got, status.Convert(err).Message())
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"sigs.k8s.io/container-object-storage-interface-api/conformance"
+	"sigs.k8s.io/container-object-storage-interface-api/fakeprovisioner"
+)
+
+func runAgainstFake(t *testing.T, ctx context.Context, s *fakeprovisioner.Server, cfg conformance.Config) *conformance.Report {
+	t.Helper()
+	conn, err := s.Dial(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	report, err := conformance.Run(ctx, conn, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return report
+}
+
+func newFake(t *testing.T) *fakeprovisioner.Server {
+	t.Helper()
+	s, err := fakeprovisioner.NewServer("fake.objectstorage.k8s.io")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+func TestRunAgainstFakeProvisioner(t *testing.T) {
+	s := newFake(t)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	report := runAgainstFake(t, ctx, s, conformance.Config{
+		Parameters:             map[string]string{"tier": "standard"},
+		IncompatibleParameters: map[string]string{"tier": "archive"},
+		AccessPolicy:           "read-write",
+		Timeout:                10 * time.Second,
+	})
+
+	if report.Driver != "fake.objectstorage.k8s.io" {
+		t.Errorf("driver is %q", report.Driver)
+	}
+	if len(report.Results) != len(conformance.Checks()) {
+		t.Errorf("got %d results, want one for each of the %d checks", len(report.Results), len(conformance.Checks()))
+	}
+	for _, result := range report.Results {
+		if result.Status != conformance.StatusPassed {
+			t.Errorf("check %s %s: %s", result.Name, result.Status, result.Message)
+		}
+	}
+	if buckets := s.Buckets(); len(buckets) != 0 {
+		t.Errorf("%d buckets left behind", len(buckets))
+	}
+	if accounts := s.Accounts(); len(accounts) != 0 {
+		t.Errorf("%d accounts left behind", len(accounts))
+	}
+}
+
+func TestRunCleansUpWhenInterrupted(t *testing.T) {
+	s := newFake(t)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	// interrupt the run while access is being granted to the bucket of the
+	// first grant check
+	s.InjectFault(fakeprovisioner.Fault{
+		Method: conformance.RPCGrantBucketAccess,
+		Match: func(proto.Message) bool {
+			cancel()
+			return true
+		},
+		Latency: time.Minute,
+		Times:   1,
+	})
+
+	report := runAgainstFake(t, ctx, s, conformance.Config{
+		Focus:   regexp.MustCompile("^grant-access"),
+		Timeout: 10 * time.Second,
+	})
+
+	if len(report.Results) != 1 {
+		t.Fatalf("got %d results, want the interrupted check only: %+v", len(report.Results), report.Results)
+	}
+	if result := report.Results[0]; result.Name != "grant-access" || result.Status != conformance.StatusFailed {
+		t.Errorf("check %s %s, want grant-access to fail", result.Name, result.Status)
+	}
+	if buckets := s.Buckets(); len(buckets) != 0 {
+		t.Errorf("%d buckets left behind", len(buckets))
+	}
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"time"
+)
+
+// Status is the outcome of a check
+type Status string
+
+const (
+	StatusPassed  Status = "passed"
+	StatusFailed  Status = "failed"
+	StatusSkipped Status = "skipped"
+)
+
+// Result is the outcome of a single check
+type Result struct {
+	Name        string        `json:"name"`
+	RPC         string        `json:"rpc"`
+	Description string        `json:"description"`
+	Status      Status        `json:"status"`
+	Message     string        `json:"message,omitempty"`
+	Duration    time.Duration `json:"duration"`
+}
+
+// Report holds the results of a conformance run
+type Report struct {
+	// Driver is the name returned by ProvisionerGetInfo
+	Driver    string        `json:"driver"`
+	StartTime time.Time     `json:"startTime"`
+	Duration  time.Duration `json:"duration"`
+	Results   []Result      `json:"results"`
+}
+
+// Count returns the number of checks with the given status
+func (r *Report) Count(status Status) int {
+	n := 0
+	for _, result := range r.Results {
+		if result.Status == status {
+			n++
+		}
+	}
+	return n
+}
+
+// Passed returns true if no check failed
+func (r *Report) Passed() bool {
+	return r.Count(StatusFailed) == 0
+}
+
+// JSON returns the report encoded as JSON
+func (r *Report) JSON() ([]byte, error) {
+	return json.MarshalIndent(r, "", "  ")
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Time      string          `xml:"time,attr"`
+	Timestamp string          `xml:"timestamp,attr"`
+	Cases     []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// JUnit returns the report in the JUnit XML format, with a test suite for each
+// RPC and a test case for each check
+func (r *Report) JUnit() ([]byte, error) {
+	suites := junitTestSuites{
+		Name:     fmt.Sprintf("COSI conformance: %s", r.Driver),
+		Tests:    len(r.Results),
+		Failures: r.Count(StatusFailed),
+		Skipped:  r.Count(StatusSkipped),
+		Time:     seconds(r.Duration),
+	}
+
+	index := map[string]int{}
+	durations := []time.Duration{}
+	for _, result := range r.Results {
+		i, ok := index[result.RPC]
+		if !ok {
+			i = len(suites.Suites)
+			index[result.RPC] = i
+			suites.Suites = append(suites.Suites, junitTestSuite{
+				Name:      result.RPC,
+				Timestamp: r.StartTime.UTC().Format(time.RFC3339),
+			})
+			durations = append(durations, 0)
+		}
+		suite := &suites.Suites[i]
+		durations[i] += result.Duration
+
+		testCase := junitTestCase{
+			Name:      result.Name,
+			ClassName: result.RPC,
+			Time:      seconds(result.Duration),
+		}
+		switch result.Status {
+		case StatusFailed:
+			testCase.Failure = &junitMessage{Message: result.Message, Text: result.Description}
+			suite.Failures++
+		case StatusSkipped:
+			testCase.Skipped = &junitMessage{Message: result.Message}
+			suite.Skipped++
+		}
+		suite.Tests++
+		suite.Cases = append(suite.Cases, testCase)
+	}
+	for i := range suites.Suites {
+		suites.Suites[i].Time = seconds(durations[i])
+	}
+
+	data, err := xml.MarshalIndent(&suites, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+}
+
+func seconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package consumer loads the BucketInfo that COSI writes to the credentials
+// secret of a BucketAccess, for use by the workloads that mount it
+package consumer
+
+import (
+	"fmt"
+	"os"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	cosiapi "sigs.k8s.io/container-object-storage-interface-api/apis"
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+)
+
+// Load decodes and validates a BucketInfo
+func Load(data []byte) (*cosiapi.BucketInfo, error) {
+	info, err := cosiapi.UnmarshalBucketInfo(data)
+	if err != nil {
+		return nil, err
+	}
+	if errs := Validate(info); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid %s: %w", cosiapi.BucketInfoKind, errs.ToAggregate())
+	}
+	return info, nil
+}
+
+// LoadFile loads a BucketInfo from a file, usually the BucketInfo key of the
+// credentials secret mounted as a volume
+func LoadFile(path string) (*cosiapi.BucketInfo, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := Load(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return info, nil
+}
+
+// LoadEnv loads a BucketInfo from an environment variable, usually populated
+// from the BucketInfo key of the credentials secret
+func LoadEnv(name string) (*cosiapi.BucketInfo, error) {
+	data, ok := os.LookupEnv(name)
+	if !ok {
+		return nil, fmt.Errorf("environment variable %s is not set", name)
+	}
+	info, err := Load([]byte(data))
+	if err != nil {
+		return nil, fmt.Errorf("environment variable %s: %w", name, err)
+	}
+	return info, nil
+}
+
+// Validate checks that a BucketInfo carries the credentials required by its
+// AuthenticationType for each of its Protocols. Keys are only required with
+// Key authentication, as workloads authenticate with their identity otherwise.
+func Validate(info *cosiapi.BucketInfo) field.ErrorList {
+	allErrs := field.ErrorList{}
+	specPath := field.NewPath("spec")
+	spec := info.Spec
+
+	if spec.BucketName == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("bucketName"), ""))
+	}
+
+	keyAuth := false
+	switch spec.AuthenticationType {
+	case v1alpha1.AuthenticationTypeKey:
+		keyAuth = true
+	case v1alpha1.AuthenticationTypeIAM:
+	default:
+		allErrs = append(allErrs, field.NotSupported(specPath.Child("authenticationType"), spec.AuthenticationType,
+			[]string{string(v1alpha1.AuthenticationTypeKey), string(v1alpha1.AuthenticationTypeIAM)}))
+	}
+
+	if len(spec.Protocols) == 0 {
+		allErrs = append(allErrs, field.Required(specPath.Child("protocols"), ""))
+	}
+	for i, protocol := range spec.Protocols {
+		switch protocol {
+		case v1alpha1.ProtocolS3:
+			allErrs = append(allErrs, validateS3(spec.S3, keyAuth, specPath.Child("secretS3"))...)
+		case v1alpha1.ProtocolAzure:
+			allErrs = append(allErrs, validateAzure(spec.Azure, keyAuth, specPath.Child("secretAzure"))...)
+		case v1alpha1.ProtocolGCP:
+			allErrs = append(allErrs, validateGCS(spec.GCS, keyAuth, specPath.Child("secretGCS"))...)
+		default:
+			allErrs = append(allErrs, field.NotSupported(specPath.Child("protocols").Index(i), protocol,
+				[]string{string(v1alpha1.ProtocolS3), string(v1alpha1.ProtocolAzure), string(v1alpha1.ProtocolGCP)}))
+		}
+	}
+
+	if spec.Encryption != nil {
+		allErrs = append(allErrs, validateEncryption(spec.Encryption, specPath.Child("encryption"))...)
+	}
+
+	return allErrs
+}
+
+func validateEncryption(encryption *cosiapi.EncryptionInfo, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	required := []string{}
+	switch encryption.Algorithm {
+	case v1alpha1.EncryptionAlgorithmProviderManaged:
+		required = []string{cosiapi.HeaderSSE}
+	case v1alpha1.EncryptionAlgorithmKMS:
+		required = []string{cosiapi.HeaderSSE, cosiapi.HeaderSSEKMSKeyID}
+	case v1alpha1.EncryptionAlgorithmCustomerKey:
+		required = []string{cosiapi.HeaderSSECustomerAlgorithm, cosiapi.HeaderSSECustomerKey, cosiapi.HeaderSSECustomerKeyMD5}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("algorithm"), encryption.Algorithm, []string{
+			string(v1alpha1.EncryptionAlgorithmProviderManaged),
+			string(v1alpha1.EncryptionAlgorithmKMS),
+			string(v1alpha1.EncryptionAlgorithmCustomerKey),
+		}))
+	}
+	for _, header := range required {
+		if encryption.Headers[header] == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("headers").Key(header), ""))
+		}
+	}
+
+	return allErrs
+}
+
+func validateS3(s3 *cosiapi.SecretS3, keyAuth bool, fldPath *field.Path) field.ErrorList {
+	if s3 == nil {
+		return field.ErrorList{field.Required(fldPath, "required for protocol S3")}
+	}
+
+	allErrs := field.ErrorList{}
+	if s3.Endpoint == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("endpoint"), ""))
+	}
+	if keyAuth {
+		if s3.AccessKeyID == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("accessKeyID"), ""))
+		}
+		if s3.AccessSecretKey == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("accessSecretKey"), ""))
+		}
+	}
+	return allErrs
+}
+
+func validateAzure(azure *cosiapi.SecretAzure, keyAuth bool, fldPath *field.Path) field.ErrorList {
+	if azure == nil {
+		return field.ErrorList{field.Required(fldPath, "required for protocol Azure")}
+	}
+
+	if keyAuth && azure.AccessToken == "" {
+		return field.ErrorList{field.Required(fldPath.Child("accessToken"), "")}
+	}
+	return nil
+}
+
+func validateGCS(gcs *cosiapi.SecretGCS, keyAuth bool, fldPath *field.Path) field.ErrorList {
+	if gcs == nil {
+		return field.ErrorList{field.Required(fldPath, "required for protocol GCP")}
+	}
+
+	allErrs := field.ErrorList{}
+	if gcs.ProjectID == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("projectID"), ""))
+	}
+	if gcs.ServiceAccount == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("serviceAccount"), ""))
+	}
+	if keyAuth {
+		if gcs.PrivateKeyData == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("privateKeyData"), ""))
+		} else if _, err := gcs.ServiceAccountKey(); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("privateKeyData"), "<redacted>", "must be a JSON key file"))
+		}
+	}
+	return allErrs
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consumer
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	cosiapi "sigs.k8s.io/container-object-storage-interface-api/apis"
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+)
+
+func bucketInfo(authenticationType v1alpha1.AuthenticationType, protocols ...v1alpha1.Protocol) *cosiapi.BucketInfo {
+	return &cosiapi.BucketInfo{
+		Spec: cosiapi.BucketInfoSpec{
+			BucketName:         "bucket",
+			AuthenticationType: authenticationType,
+			Protocols:          protocols,
+			S3: &cosiapi.SecretS3{
+				Endpoint:        "https://s3.example.com",
+				AccessKeyID:     "id",
+				AccessSecretKey: "secret",
+			},
+			Azure: &cosiapi.SecretAzure{AccessToken: "token"},
+			GCS: &cosiapi.SecretGCS{
+				ProjectID:      "project",
+				ServiceAccount: "sa@project.iam.gserviceaccount.com",
+				PrivateKeyData: `{"type":"service_account"}`,
+			},
+		},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		info     *cosiapi.BucketInfo
+		modify   func(*cosiapi.BucketInfoSpec)
+		expected []string
+	}{
+		{
+			name: "S3 with keys",
+			info: bucketInfo(v1alpha1.AuthenticationTypeKey, v1alpha1.ProtocolS3),
+		},
+		{
+			name: "Azure with keys",
+			info: bucketInfo(v1alpha1.AuthenticationTypeKey, v1alpha1.ProtocolAzure),
+		},
+		{
+			name: "GCP with keys",
+			info: bucketInfo(v1alpha1.AuthenticationTypeKey, v1alpha1.ProtocolGCP),
+		},
+		{
+			name: "all protocols with IAM",
+			info: bucketInfo(v1alpha1.AuthenticationTypeIAM, v1alpha1.ProtocolS3, v1alpha1.ProtocolAzure, v1alpha1.ProtocolGCP),
+			modify: func(spec *cosiapi.BucketInfoSpec) {
+				spec.S3.AccessKeyID = ""
+				spec.S3.AccessSecretKey = ""
+				spec.Azure.AccessToken = ""
+				spec.GCS.PrivateKeyData = ""
+			},
+		},
+		{
+			name: "S3 with keys missing",
+			info: bucketInfo(v1alpha1.AuthenticationTypeKey, v1alpha1.ProtocolS3),
+			modify: func(spec *cosiapi.BucketInfoSpec) {
+				spec.S3.AccessKeyID = ""
+				spec.S3.AccessSecretKey = ""
+			},
+			expected: []string{
+				"Required value: spec.secretS3.accessKeyID",
+				"Required value: spec.secretS3.accessSecretKey",
+			},
+		},
+		{
+			name: "S3 without endpoint",
+			info: bucketInfo(v1alpha1.AuthenticationTypeIAM, v1alpha1.ProtocolS3),
+			modify: func(spec *cosiapi.BucketInfoSpec) {
+				spec.S3.Endpoint = ""
+			},
+			expected: []string{"Required value: spec.secretS3.endpoint"},
+		},
+		{
+			name: "S3 without credentials",
+			info: bucketInfo(v1alpha1.AuthenticationTypeIAM, v1alpha1.ProtocolS3),
+			modify: func(spec *cosiapi.BucketInfoSpec) {
+				spec.S3 = nil
+			},
+			expected: []string{"Required value: spec.secretS3"},
+		},
+		{
+			name: "Azure without access token",
+			info: bucketInfo(v1alpha1.AuthenticationTypeKey, v1alpha1.ProtocolAzure),
+			modify: func(spec *cosiapi.BucketInfoSpec) {
+				spec.Azure.AccessToken = ""
+			},
+			expected: []string{"Required value: spec.secretAzure.accessToken"},
+		},
+		{
+			name: "Azure without credentials",
+			info: bucketInfo(v1alpha1.AuthenticationTypeIAM, v1alpha1.ProtocolAzure),
+			modify: func(spec *cosiapi.BucketInfoSpec) {
+				spec.Azure = nil
+			},
+			expected: []string{"Required value: spec.secretAzure"},
+		},
+		{
+			name: "GCP with keys missing",
+			info: bucketInfo(v1alpha1.AuthenticationTypeKey, v1alpha1.ProtocolGCP),
+			modify: func(spec *cosiapi.BucketInfoSpec) {
+				spec.GCS.PrivateKeyData = ""
+			},
+			expected: []string{"Required value: spec.secretGCS.privateKeyData"},
+		},
+		{
+			name: "GCP with an invalid key",
+			info: bucketInfo(v1alpha1.AuthenticationTypeKey, v1alpha1.ProtocolGCP),
+			modify: func(spec *cosiapi.BucketInfoSpec) {
+				spec.GCS.PrivateKeyData = "not json"
+			},
+			expected: []string{"Invalid value: spec.secretGCS.privateKeyData"},
+		},
+		{
+			name: "GCP without project and service account",
+			info: bucketInfo(v1alpha1.AuthenticationTypeIAM, v1alpha1.ProtocolGCP),
+			modify: func(spec *cosiapi.BucketInfoSpec) {
+				spec.GCS.ProjectID = ""
+				spec.GCS.ServiceAccount = ""
+			},
+			expected: []string{
+				"Required value: spec.secretGCS.projectID",
+				"Required value: spec.secretGCS.serviceAccount",
+			},
+		},
+		{
+			name: "GCP without credentials",
+			info: bucketInfo(v1alpha1.AuthenticationTypeIAM, v1alpha1.ProtocolGCP),
+			modify: func(spec *cosiapi.BucketInfoSpec) {
+				spec.GCS = nil
+			},
+			expected: []string{"Required value: spec.secretGCS"},
+		},
+		{
+			name:     "unsupported authentication type",
+			info:     bucketInfo("Token", v1alpha1.ProtocolS3),
+			expected: []string{"Unsupported value: spec.authenticationType"},
+		},
+		{
+			name:     "unsupported protocol",
+			info:     bucketInfo(v1alpha1.AuthenticationTypeKey, "FTP"),
+			expected: []string{"Unsupported value: spec.protocols[0]"},
+		},
+		{
+			name:     "no protocols",
+			info:     bucketInfo(v1alpha1.AuthenticationTypeKey),
+			expected: []string{"Required value: spec.protocols"},
+		},
+		{
+			name: "no bucket name",
+			info: bucketInfo(v1alpha1.AuthenticationTypeKey, v1alpha1.ProtocolS3),
+			modify: func(spec *cosiapi.BucketInfoSpec) {
+				spec.BucketName = ""
+			},
+			expected: []string{"Required value: spec.bucketName"},
+		},
+		{
+			name: "KMS encryption without key ID",
+			info: bucketInfo(v1alpha1.AuthenticationTypeKey, v1alpha1.ProtocolS3),
+			modify: func(spec *cosiapi.BucketInfoSpec) {
+				spec.Encryption = &cosiapi.EncryptionInfo{
+					Algorithm: v1alpha1.EncryptionAlgorithmKMS,
+					Headers:   map[string]string{cosiapi.HeaderSSE: "aws:kms"},
+				}
+			},
+			expected: []string{"Required value: spec.encryption.headers[" + cosiapi.HeaderSSEKMSKeyID + "]"},
+		},
+		{
+			name: "unsupported encryption algorithm",
+			info: bucketInfo(v1alpha1.AuthenticationTypeKey, v1alpha1.ProtocolS3),
+			modify: func(spec *cosiapi.BucketInfoSpec) {
+				spec.Encryption = &cosiapi.EncryptionInfo{Algorithm: "ROT13"}
+			},
+			expected: []string{"Unsupported value: spec.encryption.algorithm"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.modify != nil {
+				tc.modify(&tc.info.Spec)
+			}
+
+			errs := Validate(tc.info)
+			if got := errorStrings(errs); !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected errors %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	raw, err := bucketInfo(v1alpha1.AuthenticationTypeKey, v1alpha1.ProtocolS3).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := Load(raw)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if info.Spec.BucketName != "bucket" {
+		t.Errorf("expected bucket name %q, got %q", "bucket", info.Spec.BucketName)
+	}
+
+	if _, err := Load([]byte(`{"kind":"BucketInfo","spec":{"bucketName":"bucket"}}`)); err == nil || !strings.Contains(err.Error(), "invalid BucketInfo") {
+		t.Errorf("expected a validation error, got %v", err)
+	}
+	if _, err := Load([]byte(`{`)); err == nil {
+		t.Error("expected a decoding error")
+	}
+}
+
+// errorStrings returns the type and field of each error
+func errorStrings(errs field.ErrorList) []string {
+	var out []string
+	for _, err := range errs {
+		out = append(out, err.Type.String()+": "+err.Field)
+	}
+	return out
+}
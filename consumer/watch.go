@@ -0,0 +1,175 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consumer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"k8s.io/klog/v2"
+
+	cosiapi "sigs.k8s.io/container-object-storage-interface-api/apis"
+)
+
+// Subscriber is called with the new BucketInfo whenever the watched file
+// changes. It must not modify the BucketInfo.
+type Subscriber func(*cosiapi.BucketInfo)
+
+// Watcher keeps a BucketInfo loaded from a file up to date, so that workloads
+// pick up rotated credentials without a restart
+type Watcher struct {
+	path string
+
+	lock        sync.RWMutex
+	raw         []byte
+	current     *cosiapi.BucketInfo
+	err         error
+	subscribers []Subscriber
+}
+
+// NewWatcher loads the BucketInfo from path. It returns an error if the file
+// cannot be loaded, so that workloads fail fast on a missing mount.
+func NewWatcher(path string) (*Watcher, error) {
+	w := &Watcher{path: path}
+	if _, err := w.reload(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Current returns the BucketInfo most recently loaded. It must not be modified.
+func (w *Watcher) Current() *cosiapi.BucketInfo {
+	w.lock.RLock()
+	defer w.lock.RUnlock()
+	return w.current
+}
+
+// Err returns the error of the most recent reload, or nil if the file was
+// last loaded successfully. Workloads may report it in their health checks,
+// as Current keeps returning the previous BucketInfo. A file that is briefly
+// missing while the kubelet swaps the symlink is not an error.
+func (w *Watcher) Err() error {
+	w.lock.RLock()
+	defer w.lock.RUnlock()
+	return w.err
+}
+
+// Subscribe registers a Subscriber to be notified of changes
+func (w *Watcher) Subscribe(s Subscriber) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.subscribers = append(w.subscribers, s)
+}
+
+// Run watches the file until ctx is done. The directory of the file is watched
+// rather than the file itself, since the kubelet updates secret volumes by
+// swapping a symlink. Changes that fail to load are logged and reported by
+// Err, and the previous BucketInfo is kept.
+func (w *Watcher) Run(ctx context.Context) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	if err := watcher.Add(filepath.Dir(w.path)); err != nil {
+		return fmt.Errorf("failed to watch %s: %w", w.path, err)
+	}
+	// pick up any change made before the watch was established
+	w.update()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case _, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			w.update()
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			klog.ErrorS(err, "error watching bucket info", "path", w.path)
+		}
+	}
+}
+
+// update reloads the file and notifies subscribers if it changed
+func (w *Watcher) update() {
+	changed, err := w.reload()
+	if errors.Is(err, fs.ErrNotExist) {
+		// the file is briefly missing while the kubelet swaps the symlink
+		klog.V(4).InfoS("bucket info not found", "path", w.path)
+		return
+	}
+
+	w.lock.Lock()
+	w.err = err
+	w.lock.Unlock()
+	if err != nil {
+		klog.ErrorS(err, "failed to reload bucket info, keeping previous", "path", w.path)
+		return
+	}
+	if !changed {
+		return
+	}
+
+	w.lock.RLock()
+	info := w.current
+	subscribers := append([]Subscriber{}, w.subscribers...)
+	w.lock.RUnlock()
+
+	klog.V(2).InfoS("bucket info changed", "path", w.path, "bucket", info.Spec.BucketName)
+	for _, s := range subscribers {
+		s(info)
+	}
+}
+
+// reload loads the file and returns true if its contents changed
+func (w *Watcher) reload() (bool, error) {
+	raw, err := os.ReadFile(w.path)
+	if err != nil {
+		return false, err
+	}
+
+	w.lock.RLock()
+	unchanged := w.current != nil && bytes.Equal(raw, w.raw)
+	w.lock.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	info, err := Load(raw)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", w.path, err)
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.raw = raw
+	w.current = info
+	return true, nil
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consumer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	cosiapi "sigs.k8s.io/container-object-storage-interface-api/apis"
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+)
+
+const watchTimeout = 10 * time.Second
+
+func TestWatcher(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, cosiapi.BucketInfoSecretKey)
+	writeInfo(t, path, "first")
+
+	w, err := NewWatcher(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if name := w.Current().Spec.BucketName; name != "first" {
+		t.Fatalf("expected bucket %q, got %q", "first", name)
+	}
+
+	notified := make(chan string, 10)
+	w.Subscribe(func(info *cosiapi.BucketInfo) {
+		notified <- info.Spec.BucketName
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- w.Run(ctx)
+	}()
+	defer func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Errorf("unexpected error from Run: %v", err)
+		}
+	}()
+
+	// the kubelet replaces the file atomically
+	renameInfo(t, path, "second")
+	expectNotified(t, notified, "second")
+
+	// an invalid file is surfaced and the previous BucketInfo is kept
+	renameRaw(t, path, []byte(`{"kind":"BucketInfo","spec":{}}`))
+	waitFor(t, "reload error", func() bool { return w.Err() != nil })
+	if name := w.Current().Spec.BucketName; name != "second" {
+		t.Errorf("expected bucket %q to be kept, got %q", "second", name)
+	}
+
+	// the error is cleared by the next successful reload
+	renameInfo(t, path, "third")
+	expectNotified(t, notified, "third")
+	if err := w.Err(); err != nil {
+		t.Errorf("expected the error to be cleared, got %v", err)
+	}
+
+	// the file is briefly missing while the kubelet swaps the symlink
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	writeInfo(t, path, "fourth")
+	expectNotified(t, notified, "fourth")
+	if err := w.Err(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	select {
+	case name := <-notified:
+		t.Errorf("unexpected notification for bucket %q", name)
+	default:
+	}
+}
+
+func TestNewWatcherMissingFile(t *testing.T) {
+	if _, err := NewWatcher(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
+
+func writeInfo(t *testing.T, path, bucketName string) {
+	t.Helper()
+	info := bucketInfo(v1alpha1.AuthenticationTypeKey, v1alpha1.ProtocolS3)
+	info.Spec.BucketName = bucketName
+	raw, err := info.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, raw, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// renameInfo writes a BucketInfo next to path and renames it over path
+func renameInfo(t *testing.T, path, bucketName string) {
+	t.Helper()
+	tmp := path + ".tmp"
+	writeInfo(t, tmp, bucketName)
+	if err := os.Rename(tmp, path); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func renameRaw(t *testing.T, path string, raw []byte) {
+	t.Helper()
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, raw, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// expectNotified waits for subscribers to be notified of bucketName
+func expectNotified(t *testing.T, notified <-chan string, bucketName string) {
+	t.Helper()
+	select {
+	case name := <-notified:
+		if name != bucketName {
+			t.Fatalf("expected notification for bucket %q, got %q", bucketName, name)
+		}
+	case <-time.After(watchTimeout):
+		t.Fatalf("timed out waiting for notification for bucket %q", bucketName)
+	}
+}
+
+func waitFor(t *testing.T, what string, condition func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(watchTimeout)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
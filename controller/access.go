@@ -0,0 +1,18 @@
+package controller
+
+import (
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+)
+
+// EffectiveAccessMode returns the access mode to request from the driver for
+// access. The mode in the spec of access takes precedence over the mode of
+// class, and ReadWrite is used if neither is set.
+func EffectiveAccessMode(access *v1alpha1.BucketAccess, class *v1alpha1.BucketAccessClass) v1alpha1.AccessMode {
+	if access.Spec.AccessMode != "" {
+		return access.Spec.AccessMode
+	}
+	if class != nil && class.AccessMode != "" {
+		return class.AccessMode
+	}
+	return v1alpha1.AccessModeReadWrite
+}
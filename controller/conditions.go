@@ -0,0 +1,79 @@
+package controller
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NewCondition returns a condition of the given type observed at generation.
+// LastTransitionTime is filled in by SetCondition.
+func NewCondition(conditionType string, status metav1.ConditionStatus, reason, message string, generation int64) metav1.Condition {
+	return metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: generation,
+	}
+}
+
+// SetCondition adds newCondition to conditions, or updates the existing
+// condition of the same type. LastTransitionTime is only bumped when the
+// status changes. It returns true if conditions was modified, so that
+// listeners can skip the status update otherwise.
+func SetCondition(conditions *[]metav1.Condition, newCondition metav1.Condition) bool {
+	if existing := meta.FindStatusCondition(*conditions, newCondition.Type); existing != nil && ConditionEqual(*existing, newCondition) {
+		return false
+	}
+	meta.SetStatusCondition(conditions, newCondition)
+	return true
+}
+
+// RemoveCondition removes the condition of the given type from conditions.
+// It returns true if a condition was removed.
+func RemoveCondition(conditions *[]metav1.Condition, conditionType string) bool {
+	if meta.FindStatusCondition(*conditions, conditionType) == nil {
+		return false
+	}
+	meta.RemoveStatusCondition(conditions, conditionType)
+	return true
+}
+
+// GetCondition returns the condition of the given type, or nil if it is not present.
+func GetCondition(conditions []metav1.Condition, conditionType string) *metav1.Condition {
+	return meta.FindStatusCondition(conditions, conditionType)
+}
+
+// IsConditionTrue returns true if the condition of the given type is present and has status True.
+func IsConditionTrue(conditions []metav1.Condition, conditionType string) bool {
+	return meta.IsStatusConditionTrue(conditions, conditionType)
+}
+
+// IsConditionFalse returns true if the condition of the given type is present and has status False.
+func IsConditionFalse(conditions []metav1.Condition, conditionType string) bool {
+	return meta.IsStatusConditionFalse(conditions, conditionType)
+}
+
+// ConditionEqual compares two conditions ignoring LastTransitionTime.
+func ConditionEqual(a, b metav1.Condition) bool {
+	return a.Type == b.Type &&
+		a.Status == b.Status &&
+		a.Reason == b.Reason &&
+		a.Message == b.Message &&
+		a.ObservedGeneration == b.ObservedGeneration
+}
+
+// ConditionsEqual compares two lists of conditions by type, ignoring order
+// and LastTransitionTime.
+func ConditionsEqual(a, b []metav1.Condition) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, ca := range a {
+		cb := meta.FindStatusCondition(b, ca.Type)
+		if cb == nil || !ConditionEqual(ca, *cb) {
+			return false
+		}
+	}
+	return true
+}
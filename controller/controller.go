@@ -2,6 +2,7 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"reflect"
@@ -12,13 +13,16 @@ import (
 
 	v1alpha1 "sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
 	bucketclientset "sigs.k8s.io/container-object-storage-interface-api/client/clientset/versioned"
+	bucketinformers "sigs.k8s.io/container-object-storage-interface-api/client/informers/externalversions"
+	bucketlisters "sigs.k8s.io/container-object-storage-interface-api/client/listers/objectstorage/v1alpha1"
+	"sigs.k8s.io/container-object-storage-interface-api/controller/events"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	kubeinformers "k8s.io/client-go/informers"
 	kubeclientset "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
@@ -41,9 +45,9 @@ type deleteFunc func(ctx context.Context, obj interface{}) error
 type addOp struct {
 	Object  interface{}
 	AddFunc *addFunc
-	Indexer cache.Indexer
 
-	Key string
+	Key      string
+	Resource string
 }
 
 func (a addOp) String() string {
@@ -54,9 +58,12 @@ type updateOp struct {
 	OldObject  interface{}
 	NewObject  interface{}
 	UpdateFunc *updateFunc
-	Indexer    cache.Indexer
+	// Resync is set when the update was queued for a change to a dependent
+	// object rather than to the object itself
+	Resync bool
 
-	Key string
+	Key      string
+	Resource string
 }
 
 func (u updateOp) String() string {
@@ -66,9 +73,9 @@ func (u updateOp) String() string {
 type deleteOp struct {
 	Object     interface{}
 	DeleteFunc *deleteFunc
-	Indexer    cache.Indexer
 
-	Key string
+	Key      string
+	Resource string
 }
 
 func (d deleteOp) String() string {
@@ -88,6 +95,53 @@ type ObjectStorageController struct {
 	queue        workqueue.RateLimitingInterface
 	threadiness  int
 
+	// MaxRetries is the number of times a failed operation is retried with
+	// backoff before it is dropped. Operations are retried forever if it is 0
+	MaxRetries int
+
+	// Metrics
+	// MetricsAddress is the address to serve prometheus metrics on. Metrics
+	// are not served if it is empty
+	MetricsAddress string
+
+	// Health
+	// HealthAddress is the address to serve /healthz and /readyz on. Health
+	// checks are not served if it is empty. /readyz succeeds on the leader
+	// once the caches of all listeners have synced, and on the other
+	// replicas once they take part in leader election
+	HealthAddress string
+
+	// Scope
+	// Selectors restricts the objects of watched resources on the API server,
+	// keyed by resource name such as BucketResourceName. Run fails for other
+	// keys. Secrets are restricted to those with the CredentialsSecretLabel
+	// label unless they have an entry
+	Selectors map[ResourceName]WatchSelector
+
+	// Namespaces restricts namespaced resources to the listed namespaces.
+	// Cluster scoped resources are not affected. A single namespace is
+	// watched on its own, but several namespaces are watched across all
+	// namespaces and filtered by the controller, which requires RBAC to list
+	// and watch the resources in all namespaces. BucketClaims referred to from
+	// other namespaces are read from the API server, see ResolveBucketClaim
+	Namespaces []string
+
+	// DriverName restricts Buckets, BucketClasses and BucketAccessClasses to
+	// those of the driver. Other drivers' objects are still cached, but their
+	// events are ignored
+	DriverName string
+
+	// Dependents
+	// WatchCredentialsSecrets resyncs a BucketAccess when its credentials
+	// Secret changes or is deleted. The Secrets are watched through
+	// SecretResource, which only caches Secrets with the CredentialsSecretLabel
+	// label unless Selectors has an entry for SecretResourceName
+	WatchCredentialsSecrets bool
+
+	// WatchServiceAccounts resyncs a BucketAccess when its ServiceAccount
+	// changes or is deleted
+	WatchServiceAccounts bool
+
 	// Listeners
 	BucketListener            BucketListener
 	BucketClaimListener       BucketClaimListener
@@ -95,6 +149,10 @@ type ObjectStorageController struct {
 	BucketClassListener       BucketClassListener
 	BucketAccessClassListener BucketAccessClassListener
 
+	// registrations are the listeners added with AddListener and the typed
+	// Add*Listener methods, in the order they were added
+	registrations []registration
+
 	// leader election
 	leaderLock string
 	identity   string
@@ -107,6 +165,22 @@ type ObjectStorageController struct {
 	lockerLock sync.Mutex
 	locker     map[types.UID]*sync.Mutex
 	opMap      *sync.Map
+
+	// factoryLock guards the informer factories, which listeners may request
+	// informers from while the controller runs, and informersStop, which is
+	// set once the controller started the informers
+	factoryLock         sync.Mutex
+	informerFactory     bucketinformers.SharedInformerFactory
+	kubeInformerFactory kubeinformers.SharedInformerFactory
+	informersStop       <-chan struct{}
+	listers             *Listers
+
+	// watchedLock guards watched and the leader election state
+	watchedLock   sync.RWMutex
+	watched       []watchedResource
+	electing      bool
+	leading       bool
+	leaderHealthz *leaderelection.HealthzAdaptor
 }
 
 func NewDefaultObjectStorageController(identity string, leaderLockName string, threads int) (*ObjectStorageController, error) {
@@ -166,7 +240,7 @@ func NewObjectStorageControllerWithClientset(identity string, leaderLockName str
 		bucketClient: bucketClient,
 		initialized:  false,
 		leaderLock:   leaderLockName,
-		queue:        workqueue.NewRateLimitingQueue(limiter),
+		queue:        workqueue.NewNamedRateLimitingQueue(limiter, queueName),
 		threadiness:  threads,
 
 		ResyncPeriod: 30 * time.Second,
@@ -175,7 +249,8 @@ func NewObjectStorageControllerWithClientset(identity string, leaderLockName str
 		RenewDeadline: 120 * time.Second,
 		RetryPeriod:   60 * time.Second,
 
-		opMap: &sync.Map{},
+		opMap:         &sync.Map{},
+		leaderHealthz: leaderelection.NewLeaderHealthzAdaptor(leaderHealthzTimeout),
 	}, nil
 }
 
@@ -184,6 +259,9 @@ func (c *ObjectStorageController) Run(ctx context.Context) error {
 	if !c.initialized {
 		return fmt.Errorf("Uninitialized controller. Atleast 1 listener should be added")
 	}
+	if err := c.validateScope(); err != nil {
+		return err
+	}
 
 	ns := func() string {
 		if ns := os.Getenv("POD_NAMESPACE"); ns != "" {
@@ -203,6 +281,8 @@ func (c *ObjectStorageController) Run(ctx context.Context) error {
 		return fmt.Errorf("error getting the default leader identity: %v", err)
 	}
 
+	c.serve(ctx)
+
 	c.eventBroadcaster.StartRecordingToSink(&corev1.EventSinkImpl{Interface: c.kubeClient.CoreV1().Events(ns)})
 	defer c.eventBroadcaster.Shutdown()
 
@@ -223,13 +303,16 @@ func (c *ObjectStorageController) Run(ctx context.Context) error {
 		LeaseDuration:   c.LeaseDuration,
 		RenewDeadline:   c.RenewDeadline,
 		RetryPeriod:     c.RetryPeriod,
+		WatchDog:        c.leaderHealthz,
 		Callbacks: leaderelection.LeaderCallbacks{
 			OnStartedLeading: func(ctx context.Context) {
 				klog.V(2).InfoS("became leader, starting controller")
+				c.setLeading(true)
 				c.runController(ctx)
 			},
 			OnStoppedLeading: func() {
 				klog.InfoS("stopped leading")
+				c.setLeading(false)
 			},
 			OnNewLeader: func(identity string) {
 				klog.V(3).InfoS("new leader detected", "name", identity)
@@ -237,6 +320,7 @@ func (c *ObjectStorageController) Run(ctx context.Context) error {
 		},
 	}
 
+	c.setElecting()
 	leaderelection.RunOrDie(ctx, leaderConfig)
 	return nil // should never reach here
 }
@@ -268,26 +352,30 @@ func (c *ObjectStorageController) processNextItem(ctx context.Context) bool {
 	c.OpLock(uuid)
 	defer c.OpUnlock(uuid)
 
+	var object interface{}
+	var resource, operation string
+	start := time.Now()
 	switch o := op.(type) {
 	case addOp:
 		add := *o.AddFunc
 		err = add(ctx, o.Object)
-		o.Indexer.Add(o.Object)
+		object, resource, operation = o.Object, o.Resource, opAdd
 	case updateOp:
 		update := *o.UpdateFunc
 		err = update(ctx, o.OldObject, o.NewObject)
-		o.Indexer.Update(o.NewObject)
+		object, resource, operation = o.NewObject, o.Resource, opUpdate
 	case deleteOp:
 		delete := *o.DeleteFunc
 		err = delete(ctx, o.Object)
-		o.Indexer.Delete(o.Object)
+		object, resource, operation = o.Object, o.Resource, opDelete
 		c.opMap.Delete(uuid)
 	default:
 		panic("unknown item in queue")
 	}
+	observeOperation(resource, operation, start, err)
 
 	// Handle the error if something went wrong
-	c.handleErr(err, uuid)
+	c.handleErr(err, uuid, object, resource, operation)
 	return true
 }
 
@@ -315,186 +403,227 @@ func (c *ObjectStorageController) GetOpLock(op types.UID) *sync.Mutex {
 }
 
 // handleErr checks if an error happened and makes sure we will retry later.
-func (c *ObjectStorageController) handleErr(err error, uuid types.UID) {
+// Terminal errors and errors that exceeded MaxRetries are dropped after an
+// event is recorded on the object.
+func (c *ObjectStorageController) handleErr(err error, uuid types.UID, object interface{}, resource, operation string) {
 	if err == nil {
+		c.queue.Forget(uuid)
 		c.opMap.Delete(uuid)
 		return
 	}
+
+	var requeueAfter *RequeueAfterError
+	switch {
+	case IsTerminalError(err):
+		klog.ErrorS(err, "dropping operation after terminal error", "resource", resource, "operation", operation)
+		c.dropOp(uuid, object, events.OperationFailed, err)
+		observeDrop(resource, operation, events.OperationFailed)
+		return
+	case errors.As(err, &requeueAfter):
+		observeRetry(resource, operation)
+		c.queue.AddAfter(uuid, requeueAfter.RequeueAfter)
+		return
+	case IsConflictError(err):
+		observeRetry(resource, operation)
+		c.queue.AddAfter(uuid, conflictRequeueDelay)
+		return
+	}
+
+	if c.MaxRetries > 0 && c.queue.NumRequeues(uuid) >= c.MaxRetries {
+		klog.ErrorS(err, "dropping operation after too many retries", "resource", resource, "operation", operation, "retries", c.MaxRetries)
+		c.dropOp(uuid, object, events.RetriesExceeded, fmt.Errorf("giving up after %d retries: %w", c.MaxRetries, err))
+		observeDrop(resource, operation, events.RetriesExceeded)
+		return
+	}
+
+	observeRetry(resource, operation)
 	c.queue.AddRateLimited(uuid)
 }
 
+// dropOp stops retrying the operation for uuid and records the reason on object
+func (c *ObjectStorageController) dropOp(uuid types.UID, object interface{}, reason string, err error) {
+	c.queue.Forget(uuid)
+	c.opMap.Delete(uuid)
+
+	if obj, ok := object.(runtime.Object); ok {
+		c.eventRecorder.Event(obj, v1.EventTypeWarning, reason, err.Error())
+	}
+}
+
+// Listers provides read access to the shared informer caches of the controller
+type Listers struct {
+	Buckets             bucketlisters.BucketLister
+	BucketClaims        bucketlisters.BucketClaimLister
+	BucketClaimGrants   bucketlisters.BucketClaimGrantLister
+	BucketAccesses      bucketlisters.BucketAccessLister
+	BucketClasses       bucketlisters.BucketClassLister
+	BucketAccessClasses bucketlisters.BucketAccessClassLister
+}
+
+// InformerFactory returns the shared informer factory that the controller runs on.
+// It is created on first use with ResyncPeriod as the default resync period.
+// Informers are only created when requested, and must be requested through the
+// Informer of a Resource to be scoped by Selectors and Namespaces.
+func (c *ObjectStorageController) InformerFactory() bucketinformers.SharedInformerFactory {
+	c.factoryLock.Lock()
+	defer c.factoryLock.Unlock()
+	if c.informerFactory == nil {
+		c.informerFactory = bucketinformers.NewSharedInformerFactory(c.bucketClient, c.ResyncPeriod)
+	}
+	return c.informerFactory
+}
+
+// Listers returns typed listers backed by the shared informer caches. The informers
+// for all COSI resources are created on first use.
+func (c *ObjectStorageController) Listers() *Listers {
+	if c.listers == nil {
+		c.listers = &Listers{
+			Buckets:             bucketlisters.NewBucketLister(BucketResource.Informer(c).GetIndexer()),
+			BucketClaims:        bucketlisters.NewBucketClaimLister(BucketClaimResource.Informer(c).GetIndexer()),
+			BucketClaimGrants:   bucketlisters.NewBucketClaimGrantLister(BucketClaimGrantResource.Informer(c).GetIndexer()),
+			BucketAccesses:      bucketlisters.NewBucketAccessLister(BucketAccessResource.Informer(c).GetIndexer()),
+			BucketClasses:       bucketlisters.NewBucketClassLister(BucketClassResource.Informer(c).GetIndexer()),
+			BucketAccessClasses: bucketlisters.NewBucketAccessClassLister(BucketAccessClassResource.Informer(c).GetIndexer()),
+		}
+	}
+	return c.listers
+}
+
+// initializeListener sets the clients of l if it is a GenericListener, and
+// the listers if it is a ListerListener
+func (c *ObjectStorageController) initializeListener(l interface{}) {
+	if gl, ok := l.(GenericListener); ok {
+		gl.InitializeKubeClient(c.kubeClient)
+		gl.InitializeBucketClient(c.bucketClient)
+		gl.InitializeEventRecorder(c.eventRecorder)
+	}
+	if ll, ok := l.(ListerListener); ok {
+		ll.InitializeListers(c.Listers())
+	}
+}
+
 func (c *ObjectStorageController) runController(ctx context.Context) {
-	controllerFor := func(name string, objType runtime.Object, add addFunc, update updateFunc, delete deleteFunc) {
-		indexer := cache.NewIndexer(cache.DeletionHandlingMetaNamespaceKeyFunc, cache.Indexers{})
-		resyncPeriod := c.ResyncPeriod
-
-		lw := cache.NewListWatchFromClient(c.bucketClient.ObjectstorageV1alpha1().RESTClient(), name, "", fields.Everything())
-		cfg := &cache.Config{
-			Queue: cache.NewDeltaFIFOWithOptions(cache.DeltaFIFOOptions{
-				KnownObjects:          indexer,
-				EmitDeltaTypeReplaced: false,
-			}),
-			ListerWatcher:    lw,
-			ObjectType:       objType,
-			FullResyncPeriod: resyncPeriod,
-			RetryOnError:     true,
-			Process: func(obj interface{}) error {
-				for _, d := range obj.(cache.Deltas) {
-					switch d.Type {
-					case cache.Sync, cache.Replaced, cache.Added, cache.Updated:
-						if old, exists, err := indexer.Get(d.Object); err == nil && exists {
-							key, err := cache.MetaNamespaceKeyFunc(d.Object)
-							if err != nil {
-								panic(err)
-							}
-
-							if reflect.DeepEqual(d.Object, old) {
-								return nil
-							}
-
-							uuid := d.Object.(metav1.Object).GetUID()
-
-							c.opMap.Store(uuid, updateOp{
-								OldObject:  old,
-								NewObject:  d.Object,
-								UpdateFunc: &update,
-								Key:        key,
-								Indexer:    indexer,
-							})
-							c.queue.Add(uuid)
-						} else {
-							key, err := cache.MetaNamespaceKeyFunc(d.Object)
-							if err != nil {
-								panic(err)
-							}
-
-							uuid := d.Object.(metav1.Object).GetUID()
-
-							if op, ok := c.opMap.LoadOrStore(uuid, addOp{
-								Object:  d.Object,
-								AddFunc: &add,
-								Key:     key,
-								Indexer: indexer,
-							}); ok { // If an update to the k8s object happens before add has succeeded
-								if _, ok := op.(updateOp); ok {
-									err := fmt.Errorf("cannot add already added object: %s", key)
-									return err
-								}
-							}
-							c.queue.Add(uuid)
-						}
-					case cache.Deleted:
-						key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(d.Object)
-						if err != nil {
-							panic(err)
-						}
-
-						uuid := d.Object.(metav1.Object).GetUID()
-						c.opMap.Store(uuid, deleteOp{
-							Object:     d.Object,
-							DeleteFunc: &delete,
-							Key:        key,
-							Indexer:    indexer,
-						})
-						c.queue.Add(uuid)
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	controllerFor := func(name string, informer cache.SharedIndexInformer, add addFunc, update updateFunc, delete deleteFunc) {
+		informer.AddEventHandler(cache.FilteringResourceEventHandler{FilterFunc: c.inScope, Handler: cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				key, err := cache.MetaNamespaceKeyFunc(obj)
+				if err != nil {
+					panic(err)
+				}
+
+				uuid := obj.(metav1.Object).GetUID()
+
+				// If an update to the k8s object happens before add has succeeded,
+				// the pending operation is kept. A pending resync is replaced, as
+				// the object has not been added yet.
+				op := addOp{
+					Object:   obj,
+					AddFunc:  &add,
+					Key:      key,
+					Resource: name,
+				}
+				if pending, loaded := c.opMap.LoadOrStore(uuid, op); loaded {
+					if u, ok := pending.(updateOp); ok && u.Resync {
+						c.opMap.Store(uuid, op)
 					}
 				}
-				return nil
+				c.queue.Add(uuid)
 			},
-		}
-		ctrlr := cache.New(cfg)
+			UpdateFunc: func(old, new interface{}) {
+				if reflect.DeepEqual(old, new) {
+					return
+				}
 
-		defer utilruntime.HandleCrash()
-		defer c.queue.ShutDown()
+				key, err := cache.MetaNamespaceKeyFunc(new)
+				if err != nil {
+					panic(err)
+				}
 
-		go ctrlr.Run(ctx.Done())
+				uuid := new.(metav1.Object).GetUID()
 
-		if !cache.WaitForCacheSync(ctx.Done(), ctrlr.HasSynced) {
-			utilruntime.HandleError(fmt.Errorf("Timed out waiting for caches to sync"))
-			return
-		}
+				c.opMap.Store(uuid, updateOp{
+					OldObject:  old,
+					NewObject:  new,
+					UpdateFunc: &update,
+					Key:        key,
+					Resource:   name,
+				})
+				c.queue.Add(uuid)
+			},
+			DeleteFunc: func(obj interface{}) {
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
 
-		for i := 0; i < c.threadiness; i++ {
-			go c.runWorker(ctx)
-		}
+				key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+				if err != nil {
+					panic(err)
+				}
 
-		<-ctx.Done()
+				uuid := obj.(metav1.Object).GetUID()
+				c.opMap.Store(uuid, deleteOp{
+					Object:     obj,
+					DeleteFunc: &delete,
+					Key:        key,
+					Resource:   name,
+				})
+				c.queue.Add(uuid)
+			},
+		}})
+		c.addWatchedResource(name, informer)
 	}
 
-	if c.BucketListener != nil {
-		c.BucketListener.InitializeKubeClient(c.kubeClient)
-		c.BucketListener.InitializeBucketClient(c.bucketClient)
-		c.BucketListener.InitializeEventRecorder(c.eventRecorder)
-		addFunc := func(ctx context.Context, obj interface{}) error {
-			return c.BucketListener.Add(ctx, obj.(*v1alpha1.Bucket))
-		}
-		updateFunc := func(ctx context.Context, old interface{}, new interface{}) error {
-			return c.BucketListener.Update(ctx, old.(*v1alpha1.Bucket), new.(*v1alpha1.Bucket))
-		}
-		deleteFunc := func(ctx context.Context, obj interface{}) error {
-			return c.BucketListener.Delete(ctx, obj.(*v1alpha1.Bucket))
-		}
-		go controllerFor("Buckets", &v1alpha1.Bucket{}, addFunc, updateFunc, deleteFunc)
-	}
-	if c.BucketClaimListener != nil {
-		c.BucketClaimListener.InitializeKubeClient(c.kubeClient)
-		c.BucketClaimListener.InitializeBucketClient(c.bucketClient)
-		c.BucketClaimListener.InitializeEventRecorder(c.eventRecorder)
-		addFunc := func(ctx context.Context, obj interface{}) error {
-			return c.BucketClaimListener.Add(ctx, obj.(*v1alpha1.BucketClaim))
-		}
-		updateFunc := func(ctx context.Context, old interface{}, new interface{}) error {
-			return c.BucketClaimListener.Update(ctx, old.(*v1alpha1.BucketClaim), new.(*v1alpha1.BucketClaim))
-		}
-		deleteFunc := func(ctx context.Context, obj interface{}) error {
-			return c.BucketClaimListener.Delete(ctx, obj.(*v1alpha1.BucketClaim))
-		}
-		go controllerFor("BucketClaims", &v1alpha1.BucketClaim{}, addFunc, updateFunc, deleteFunc)
-	}
-	if c.BucketAccessListener != nil {
-		c.BucketAccessListener.InitializeKubeClient(c.kubeClient)
-		c.BucketAccessListener.InitializeBucketClient(c.bucketClient)
-		c.BucketAccessListener.InitializeEventRecorder(c.eventRecorder)
-		addFunc := func(ctx context.Context, obj interface{}) error {
-			return c.BucketAccessListener.Add(ctx, obj.(*v1alpha1.BucketAccess))
-		}
-		updateFunc := func(ctx context.Context, old interface{}, new interface{}) error {
-			return c.BucketAccessListener.Update(ctx, old.(*v1alpha1.BucketAccess), new.(*v1alpha1.BucketAccess))
-		}
-		deleteFunc := func(ctx context.Context, obj interface{}) error {
-			return c.BucketAccessListener.Delete(ctx, obj.(*v1alpha1.BucketAccess))
-		}
-		go controllerFor("BucketAccesses", &v1alpha1.BucketAccess{}, addFunc, updateFunc, deleteFunc)
-	}
-	if c.BucketClassListener != nil {
-		c.BucketClassListener.InitializeKubeClient(c.kubeClient)
-		c.BucketClassListener.InitializeBucketClient(c.bucketClient)
-		c.BucketClassListener.InitializeEventRecorder(c.eventRecorder)
-		addFunc := func(ctx context.Context, obj interface{}) error {
-			return c.BucketClassListener.Add(ctx, obj.(*v1alpha1.BucketClass))
-		}
-		updateFunc := func(ctx context.Context, old interface{}, new interface{}) error {
-			return c.BucketClassListener.Update(ctx, old.(*v1alpha1.BucketClass), new.(*v1alpha1.BucketClass))
-		}
-		deleteFunc := func(ctx context.Context, obj interface{}) error {
-			return c.BucketClassListener.Delete(ctx, obj.(*v1alpha1.BucketClass))
+	watchingAccesses := false
+	for _, r := range c.listenerRegistrations() {
+		c.initializeListener(r.listener)
+		informer := r.informer(c)
+		controllerFor(r.name, informer, r.add, r.update, r.delete)
+
+		if r.name == BucketAccessResource.Name {
+			watchingAccesses = true
+			if err := c.watchDependents(informer, r.update); err != nil {
+				utilruntime.HandleError(err)
+			}
 		}
-		go controllerFor("BucketClasses", &v1alpha1.BucketClass{}, addFunc, updateFunc, deleteFunc)
-	}
-	if c.BucketAccessClassListener != nil {
-		c.BucketAccessClassListener.InitializeKubeClient(c.kubeClient)
-		c.BucketAccessClassListener.InitializeBucketClient(c.bucketClient)
-		c.BucketAccessClassListener.InitializeEventRecorder(c.eventRecorder)
-		addFunc := func(ctx context.Context, obj interface{}) error {
-			return c.BucketAccessClassListener.Add(ctx, obj.(*v1alpha1.BucketAccessClass))
+	}
+	if (c.WatchCredentialsSecrets || c.WatchServiceAccounts) && !watchingAccesses {
+		klog.InfoS("not watching credentials secrets and service accounts without a BucketAccess listener")
+	}
+
+	// informers requested from now on are started by startInformers
+	c.factoryLock.Lock()
+	c.informersStop = ctx.Done()
+	c.factoryLock.Unlock()
+	c.startInformers()
+
+	// Listeners read the listers of resources that they do not watch, so no
+	// worker starts before every cache has synced
+	for informerType, synced := range c.InformerFactory().WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			utilruntime.HandleError(fmt.Errorf("Timed out waiting for %v caches to sync", informerType))
+			return
 		}
-		updateFunc := func(ctx context.Context, old interface{}, new interface{}) error {
-			return c.BucketAccessClassListener.Update(ctx, old.(*v1alpha1.BucketAccessClass), new.(*v1alpha1.BucketAccessClass))
+	}
+	if kubeInformerFactory := c.startedKubeInformerFactory(); kubeInformerFactory != nil {
+		for informerType, synced := range kubeInformerFactory.WaitForCacheSync(ctx.Done()) {
+			if !synced {
+				utilruntime.HandleError(fmt.Errorf("Timed out waiting for %v caches to sync", informerType))
+				return
+			}
 		}
-		deleteFunc := func(ctx context.Context, obj interface{}) error {
-			return c.BucketAccessClassListener.Delete(ctx, obj.(*v1alpha1.BucketAccessClass))
+	}
+	for _, r := range c.watchedResources() {
+		if !cache.WaitForCacheSync(ctx.Done(), r.informer.HasSynced) {
+			utilruntime.HandleError(fmt.Errorf("Timed out waiting for %s caches to sync", r.name))
+			return
 		}
-		go controllerFor("BucketAccessClasses", &v1alpha1.BucketAccessClass{}, addFunc, updateFunc, deleteFunc)
+	}
+
+	for i := 0; i < c.threadiness; i++ {
+		go c.runWorker(ctx)
 	}
 
 	<-ctx.Done()
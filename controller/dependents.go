@@ -0,0 +1,156 @@
+package controller
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+)
+
+const (
+	// CredentialsSecretIndex indexes BucketAccesses by the namespace/name key
+	// of their credentials Secret
+	CredentialsSecretIndex = "credentialsSecret"
+
+	// ServiceAccountIndex indexes BucketAccesses by the namespace/name key of
+	// their ServiceAccount
+	ServiceAccountIndex = "serviceAccount"
+
+	// CredentialsSecretLabel must be set by sidecars on the credentials
+	// Secrets they create, with the name of the BucketAccess as value. Only
+	// Secrets with the label are watched, unless Selectors has an entry for
+	// SecretResourceName.
+	CredentialsSecretLabel = "objectstorage.k8s.io/bucketaccess"
+)
+
+// Names of the dependent resources in health checks
+const (
+	credentialsSecretsResource = "CredentialsSecrets"
+	serviceAccountsResource    = "BucketAccessServiceAccounts"
+)
+
+func credentialsSecretIndexFunc(obj interface{}) ([]string, error) {
+	access, ok := obj.(*v1alpha1.BucketAccess)
+	if !ok || access.Spec.CredentialsSecretName == "" {
+		return nil, nil
+	}
+	return []string{access.Namespace + "/" + access.Spec.CredentialsSecretName}, nil
+}
+
+func serviceAccountIndexFunc(obj interface{}) ([]string, error) {
+	access, ok := obj.(*v1alpha1.BucketAccess)
+	if !ok || access.Spec.ServiceAccountName == "" {
+		return nil, nil
+	}
+	return []string{access.Namespace + "/" + access.Spec.ServiceAccountName}, nil
+}
+
+// watchDependents watches the credentials Secrets and ServiceAccounts of
+// BucketAccesses as configured, and passes the BucketAccess that refers to a
+// changed or deleted object to the Update of its listener, with the current
+// BucketAccess as both old and new. This lets the listener restore drifted
+// credentials without waiting for a resync.
+func (c *ObjectStorageController) watchDependents(accesses cache.SharedIndexInformer, update updateFunc) error {
+	if !c.WatchCredentialsSecrets && !c.WatchServiceAccounts {
+		return nil
+	}
+
+	indexers := cache.Indexers{}
+	if c.WatchCredentialsSecrets {
+		indexers[CredentialsSecretIndex] = credentialsSecretIndexFunc
+	}
+	if c.WatchServiceAccounts {
+		indexers[ServiceAccountIndex] = serviceAccountIndexFunc
+	}
+	if err := accesses.AddIndexers(indexers); err != nil {
+		return fmt.Errorf("failed to index BucketAccesses: %w", err)
+	}
+
+	if c.WatchCredentialsSecrets {
+		informer := SecretResource.Informer(c)
+		informer.AddEventHandler(c.dependentHandler(informer, accesses.GetIndexer(), CredentialsSecretIndex, update))
+		c.addWatchedResource(credentialsSecretsResource, informer)
+	}
+	if c.WatchServiceAccounts {
+		informer := ServiceAccountResource.Informer(c)
+		informer.AddEventHandler(c.dependentHandler(informer, accesses.GetIndexer(), ServiceAccountIndex, update))
+		c.addWatchedResource(serviceAccountsResource, informer)
+	}
+	return nil
+}
+
+// dependentHandler enqueues the BucketAccesses found in index under the key of
+// the object of an event of informer. Objects listed while the cache of
+// informer syncs and resyncs of unchanged objects are ignored.
+func (c *ObjectStorageController) dependentHandler(informer cache.SharedIndexInformer, indexer cache.Indexer, index string, update updateFunc) cache.ResourceEventHandler {
+	enqueue := func(obj interface{}) {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		key, err := cache.MetaNamespaceKeyFunc(obj)
+		if err != nil {
+			klog.ErrorS(err, "failed to get key of dependent object", "index", index)
+			return
+		}
+		accesses, err := indexer.ByIndex(index, key)
+		if err != nil {
+			klog.ErrorS(err, "failed to look up BucketAccesses", "index", index, "key", key)
+			return
+		}
+		for _, access := range accesses {
+			klog.V(4).InfoS("dependent object changed, resyncing BucketAccess", "index", index, "key", key)
+			c.enqueueResync(BucketAccessResource.Name, access.(*v1alpha1.BucketAccess), update)
+		}
+	}
+
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			if !informer.HasSynced() {
+				return
+			}
+			enqueue(obj)
+		},
+		UpdateFunc: func(old, new interface{}) {
+			if old.(metav1.Object).GetResourceVersion() == new.(metav1.Object).GetResourceVersion() {
+				return
+			}
+			enqueue(new)
+		},
+		DeleteFunc: enqueue,
+	}
+}
+
+// enqueueResync queues an update of access to itself. An operation that is
+// already pending for access is kept, as it will see the current state anyway,
+// and a pending resync is replaced by the add of access if it comes later.
+func (c *ObjectStorageController) enqueueResync(resource string, access *v1alpha1.BucketAccess, update updateFunc) {
+	if !c.inScope(access) {
+		return
+	}
+	key, err := cache.MetaNamespaceKeyFunc(access)
+	if err != nil {
+		klog.ErrorS(err, "failed to get key of BucketAccess")
+		return
+	}
+	c.opMap.LoadOrStore(access.UID, updateOp{
+		OldObject:  access,
+		NewObject:  access,
+		UpdateFunc: &update,
+		Resync:     true,
+		Key:        key,
+		Resource:   resource,
+	})
+	c.queue.Add(access.UID)
+}
+
+// IsCredentialsSecret returns true if secret is labelled as the credentials
+// Secret of access
+func IsCredentialsSecret(secret *v1.Secret, access *v1alpha1.BucketAccess) bool {
+	return secret.Namespace == access.Namespace &&
+		secret.Name == access.Spec.CredentialsSecretName &&
+		secret.Labels[CredentialsSecretLabel] == access.Name
+}
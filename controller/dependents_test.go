@@ -0,0 +1,128 @@
+package controller
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+)
+
+// syncedInformer is an informer whose cache has synced
+type syncedInformer struct {
+	cache.SharedIndexInformer
+}
+
+func (syncedInformer) HasSynced() bool {
+	return true
+}
+
+func TestDependentHandler(t *testing.T) {
+	access := &v1alpha1.BucketAccess{
+		ObjectMeta: metav1.ObjectMeta{Name: "access", Namespace: "ns", UID: "access-uid"},
+		Spec:       v1alpha1.BucketAccessSpec{CredentialsSecretName: "creds"},
+	}
+	other := &v1alpha1.BucketAccess{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "other", UID: "other-uid"},
+		Spec:       v1alpha1.BucketAccessSpec{CredentialsSecretName: "creds"},
+	}
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{CredentialsSecretIndex: credentialsSecretIndexFunc})
+	for _, a := range []*v1alpha1.BucketAccess{access, other} {
+		if err := indexer.Add(a); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the watch only sees Secrets with CredentialsSecretLabel, but they are
+	// matched to BucketAccesses by name
+	secret := func(namespace, name, resourceVersion string) *v1.Secret {
+		return &v1.Secret{ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       namespace,
+			ResourceVersion: resourceVersion,
+			Labels:          map[string]string{CredentialsSecretLabel: "access"},
+		}}
+	}
+
+	tests := []struct {
+		name       string
+		namespaces []string
+		event      func(h cache.ResourceEventHandler)
+		want       []types.UID
+	}{
+		{
+			name:  "added",
+			event: func(h cache.ResourceEventHandler) { h.OnAdd(secret("ns", "creds", "1")) },
+			want:  []types.UID{"access-uid"},
+		},
+		{
+			name:  "updated",
+			event: func(h cache.ResourceEventHandler) { h.OnUpdate(secret("ns", "creds", "1"), secret("ns", "creds", "2")) },
+			want:  []types.UID{"access-uid"},
+		},
+		{
+			name:  "resynced",
+			event: func(h cache.ResourceEventHandler) { h.OnUpdate(secret("ns", "creds", "1"), secret("ns", "creds", "1")) },
+		},
+		{
+			name:  "deleted",
+			event: func(h cache.ResourceEventHandler) { h.OnDelete(secret("other", "creds", "1")) },
+			want:  []types.UID{"other-uid"},
+		},
+		{
+			name: "deleted while disconnected",
+			event: func(h cache.ResourceEventHandler) {
+				h.OnDelete(cache.DeletedFinalStateUnknown{Key: "ns/creds", Obj: secret("ns", "creds", "1")})
+			},
+			want: []types.UID{"access-uid"},
+		},
+		{
+			name:  "unrelated secret",
+			event: func(h cache.ResourceEventHandler) { h.OnUpdate(secret("ns", "other", "1"), secret("ns", "other", "2")) },
+		},
+		{
+			name:       "access out of scope",
+			namespaces: []string{"ns"},
+			event:      func(h cache.ResourceEventHandler) { h.OnDelete(secret("other", "creds", "1")) },
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &ObjectStorageController{
+				Namespaces: test.namespaces,
+				opMap:      &sync.Map{},
+				queue:      workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+			}
+			defer c.queue.ShutDown()
+			update := func(ctx context.Context, old, new interface{}) error { return nil }
+
+			test.event(c.dependentHandler(syncedInformer{}, indexer, CredentialsSecretIndex, update))
+
+			var got []types.UID
+			for c.queue.Len() > 0 {
+				item, _ := c.queue.Get()
+				uid := item.(types.UID)
+				got = append(got, uid)
+				c.queue.Done(item)
+
+				op, ok := c.opMap.Load(uid)
+				if !ok {
+					t.Fatalf("no operation pending for %s", uid)
+				}
+				if u, ok := op.(updateOp); !ok || !u.Resync || u.Resource != BucketAccessResource.Name {
+					t.Errorf("pending operation for %s is %#v, want a resync of the BucketAccess", uid, op)
+				}
+			}
+			if len(got) != len(test.want) || (len(got) > 0 && got[0] != test.want[0]) {
+				t.Errorf("queued %v, want %v", got, test.want)
+			}
+		})
+	}
+}
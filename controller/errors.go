@@ -0,0 +1,90 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// conflictRequeueDelay is the delay before an operation that failed with a
+// conflict is retried
+const conflictRequeueDelay = time.Second
+
+// TerminalError is returned by listeners for errors that will not go away by
+// retrying, such as an invalid spec. The operation is dropped after an event
+// is recorded on the object, and is only attempted again once the object changes.
+type TerminalError struct {
+	Err error
+}
+
+func (e *TerminalError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *TerminalError) Unwrap() error {
+	return e.Err
+}
+
+// NewTerminalError marks err as terminal
+func NewTerminalError(err error) error {
+	return &TerminalError{Err: err}
+}
+
+// IsTerminalError returns true if err or any error it wraps is a TerminalError
+func IsTerminalError(err error) bool {
+	var terminal *TerminalError
+	return errors.As(err, &terminal)
+}
+
+// RequeueAfterError is returned by listeners to retry the operation after a
+// fixed delay instead of the rate limited backoff. Err may be nil when the
+// listener is waiting on something rather than failing.
+type RequeueAfterError struct {
+	Err          error
+	RequeueAfter time.Duration
+}
+
+func (e *RequeueAfterError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("requeue after %s", e.RequeueAfter)
+	}
+	return fmt.Sprintf("%v: requeue after %s", e.Err, e.RequeueAfter)
+}
+
+func (e *RequeueAfterError) Unwrap() error {
+	return e.Err
+}
+
+// NewRequeueAfterError returns an error that requeues the operation after d
+func NewRequeueAfterError(err error, d time.Duration) error {
+	return &RequeueAfterError{Err: err, RequeueAfter: d}
+}
+
+// ConflictError is returned by listeners when an update failed because the
+// object was modified concurrently. The operation is retried shortly without
+// backoff and does not count towards MaxRetries.
+type ConflictError struct {
+	Err error
+}
+
+func (e *ConflictError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ConflictError) Unwrap() error {
+	return e.Err
+}
+
+// NewConflictError marks err as a conflict
+func NewConflictError(err error) error {
+	return &ConflictError{Err: err}
+}
+
+// IsConflictError returns true if err is a ConflictError or a conflict
+// returned by the API server
+func IsConflictError(err error) bool {
+	var conflict *ConflictError
+	return errors.As(err, &conflict) || apierrors.IsConflict(err)
+}
@@ -0,0 +1,173 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+	"sigs.k8s.io/container-object-storage-interface-api/controller/events"
+)
+
+// fakeQueue records how handleErr requeues an item
+type fakeQueue struct {
+	workqueue.RateLimitingInterface
+
+	requeues     int
+	forgotten    bool
+	rateLimited  bool
+	addedAfter   bool
+	requeueAfter time.Duration
+}
+
+func (q *fakeQueue) AddAfter(item interface{}, d time.Duration) {
+	q.addedAfter = true
+	q.requeueAfter = d
+}
+
+func (q *fakeQueue) AddRateLimited(item interface{}) {
+	q.rateLimited = true
+}
+
+func (q *fakeQueue) Forget(item interface{}) {
+	q.forgotten = true
+}
+
+func (q *fakeQueue) NumRequeues(item interface{}) int {
+	return q.requeues
+}
+
+func TestHandleErr(t *testing.T) {
+	errFailed := errors.New("failed")
+	conflict := apierrors.NewConflict(schema.GroupResource{Group: "objectstorage.k8s.io", Resource: "buckets"}, "bucket", errFailed)
+
+	tests := []struct {
+		name       string
+		err        error
+		maxRetries int
+		requeues   int
+
+		// expected outcome
+		dropped      bool
+		event        string
+		rateLimited  bool
+		requeueAfter time.Duration
+	}{
+		{
+			name: "success",
+		},
+		{
+			name:    "terminal",
+			err:     NewTerminalError(errFailed),
+			dropped: true,
+			event:   events.OperationFailed,
+		},
+		{
+			name:    "wrapped terminal",
+			err:     fmt.Errorf("reconcile: %w", NewTerminalError(errFailed)),
+			dropped: true,
+			event:   events.OperationFailed,
+		},
+		{
+			name:         "requeue after without error",
+			err:          NewRequeueAfterError(nil, time.Minute),
+			requeueAfter: time.Minute,
+		},
+		{
+			name:         "requeue after with error",
+			err:          NewRequeueAfterError(errFailed, time.Minute),
+			maxRetries:   1,
+			requeues:     5,
+			requeueAfter: time.Minute,
+		},
+		{
+			name:         "conflict",
+			err:          NewConflictError(errFailed),
+			maxRetries:   1,
+			requeues:     5,
+			requeueAfter: conflictRequeueDelay,
+		},
+		{
+			name:         "conflict from the API server",
+			err:          fmt.Errorf("update status: %w", conflict),
+			requeueAfter: conflictRequeueDelay,
+		},
+		{
+			name:        "error without MaxRetries",
+			err:         errFailed,
+			requeues:    100,
+			rateLimited: true,
+		},
+		{
+			name:        "error below MaxRetries",
+			err:         errFailed,
+			maxRetries:  3,
+			requeues:    2,
+			rateLimited: true,
+		},
+		{
+			name:       "error at MaxRetries",
+			err:        errFailed,
+			maxRetries: 3,
+			requeues:   3,
+			dropped:    true,
+			event:      events.RetriesExceeded,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			uid := types.UID("uid")
+			queue := &fakeQueue{requeues: test.requeues}
+			recorder := record.NewFakeRecorder(1)
+			c := &ObjectStorageController{
+				queue:         queue,
+				opMap:         &sync.Map{},
+				eventRecorder: recorder,
+				MaxRetries:    test.maxRetries,
+			}
+			c.opMap.Store(uid, addOp{})
+			bucket := &v1alpha1.Bucket{ObjectMeta: metav1.ObjectMeta{Name: "bucket", UID: uid}}
+
+			c.handleErr(test.err, uid, bucket, "Buckets", opAdd)
+
+			_, pending := c.opMap.Load(uid)
+			finished := test.err == nil || test.dropped
+			if pending == finished {
+				t.Errorf("operation pending = %v, want %v", pending, !finished)
+			}
+			if queue.forgotten != finished {
+				t.Errorf("forgotten = %v, want %v", queue.forgotten, finished)
+			}
+			if queue.rateLimited != test.rateLimited {
+				t.Errorf("rate limited = %v, want %v", queue.rateLimited, test.rateLimited)
+			}
+			if queue.addedAfter != (test.requeueAfter != 0) || queue.requeueAfter != test.requeueAfter {
+				t.Errorf("requeued after %v, want %v", queue.requeueAfter, test.requeueAfter)
+			}
+
+			select {
+			case event := <-recorder.Events:
+				if test.event == "" {
+					t.Errorf("unexpected event %q", event)
+				} else if !strings.HasPrefix(event, "Warning "+test.event+" ") {
+					t.Errorf("event %q, want reason %s", event, test.event)
+				}
+			default:
+				if test.event != "" {
+					t.Errorf("no event, want reason %s", test.event)
+				}
+			}
+		})
+	}
+}
@@ -8,4 +8,7 @@ const (
 
 	FailedGrantAccess  = "FailedGrantAccess"
 	FailedRevokeAccess = "FailedRevokeAccess"
+
+	OperationFailed = "OperationFailed"
+	RetriesExceeded = "RetriesExceeded"
 )
@@ -0,0 +1,147 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+	bucketlisters "sigs.k8s.io/container-object-storage-interface-api/client/listers/objectstorage/v1alpha1"
+)
+
+// ErrBucketClaimNotGranted is returned when a BucketAccess refers to a
+// BucketClaim in another namespace that no BucketClaimGrant allows
+var ErrBucketClaimNotGranted = errors.New("bucket claim is not granted to the namespace of the bucket access")
+
+// BucketClaimNamespace returns the namespace of the BucketClaim referred to by access
+func BucketClaimNamespace(access *v1alpha1.BucketAccess) string {
+	if access.Spec.BucketClaimNamespace != "" {
+		return access.Spec.BucketClaimNamespace
+	}
+	return access.Namespace
+}
+
+// GrantAllows returns true if grant allows BucketAccesses in fromNamespace to
+// refer to the BucketClaim claimName in the namespace of the grant
+func GrantAllows(grant *v1alpha1.BucketClaimGrant, fromNamespace, claimName string) bool {
+	from := false
+	for _, f := range grant.Spec.From {
+		if f.Namespace == fromNamespace {
+			from = true
+			break
+		}
+	}
+	if !from {
+		return false
+	}
+
+	for _, t := range grant.Spec.To {
+		if t.Name == "" || t.Name == claimName {
+			return true
+		}
+	}
+	return false
+}
+
+// BucketClaimGranted returns true if BucketAccesses in fromNamespace may refer
+// to the BucketClaim claimName in claimNamespace. References within a namespace
+// are always allowed.
+func BucketClaimGranted(lister bucketlisters.BucketClaimGrantLister, fromNamespace, claimNamespace, claimName string) (bool, error) {
+	if fromNamespace == claimNamespace {
+		return true, nil
+	}
+
+	grants, err := lister.BucketClaimGrants(claimNamespace).List(labels.Everything())
+	if err != nil {
+		return false, err
+	}
+	for _, grant := range grants {
+		if GrantAllows(grant, fromNamespace, claimName) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ResolveBucketClaim returns the BucketClaim referred to by access. It returns
+// an error wrapping ErrBucketClaimNotGranted if the claim is in another
+// namespace and no BucketClaimGrant allows the reference. The grant is checked
+// before the claim is looked up, so that the existence of claims in other
+// namespaces is not revealed.
+//
+// BucketClaimGrants and BucketClaims are read from the informer caches when
+// the Namespaces and Selectors of the controller let them see the namespace of
+// the claim, and from the API server otherwise or until the caches have synced. Controllers restricted to some
+// namespaces therefore need RBAC to list bucketclaimgrants and get bucketclaims
+// in the namespaces that their BucketAccesses refer to.
+func (c *ObjectStorageController) ResolveBucketClaim(ctx context.Context, access *v1alpha1.BucketAccess) (*v1alpha1.BucketClaim, error) {
+	namespace := BucketClaimNamespace(access)
+	name := access.Spec.BucketClaimName
+
+	granted, err := c.bucketClaimGranted(ctx, access.Namespace, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	if !granted {
+		return nil, fmt.Errorf("%s/%s: %w", namespace, name, ErrBucketClaimNotGranted)
+	}
+
+	if informer := c.cachedInformer(BucketClaimResource.Informer, BucketClaimResourceName, namespace); informer != nil {
+		return bucketlisters.NewBucketClaimLister(informer.GetIndexer()).BucketClaims(namespace).Get(name)
+	}
+	return c.bucketClient.ObjectstorageV1alpha1().BucketClaims(namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+// bucketClaimGranted is BucketClaimGranted with a live list of the grants if
+// they are not cached for claimNamespace
+func (c *ObjectStorageController) bucketClaimGranted(ctx context.Context, fromNamespace, claimNamespace, claimName string) (bool, error) {
+	if fromNamespace == claimNamespace {
+		return true, nil
+	}
+	if informer := c.cachedInformer(BucketClaimGrantResource.Informer, BucketClaimGrantResourceName, claimNamespace); informer != nil {
+		return BucketClaimGranted(bucketlisters.NewBucketClaimGrantLister(informer.GetIndexer()), fromNamespace, claimNamespace, claimName)
+	}
+
+	grants, err := c.bucketClient.ObjectstorageV1alpha1().BucketClaimGrants(claimNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return false, err
+	}
+	for i := range grants.Items {
+		if GrantAllows(&grants.Items[i], fromNamespace, claimName) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// cachedInformer returns the informer of the resource with the given name if
+// it caches all its objects in namespace and has synced, and nil otherwise.
+// An informer that has not synced yet is still requested, so that it runs for
+// later calls.
+func (c *ObjectStorageController) cachedInformer(informerFor func(*ObjectStorageController) cache.SharedIndexInformer, name ResourceName, namespace string) cache.SharedIndexInformer {
+	if s := c.selector(name); s.LabelSelector != "" || s.FieldSelector != "" {
+		return nil
+	}
+	if len(c.Namespaces) > 0 {
+		found := false
+		for _, ns := range c.Namespaces {
+			if ns == namespace {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil
+		}
+	}
+
+	informer := informerFor(c)
+	if !informer.HasSynced() {
+		return nil
+	}
+	return informer
+}
@@ -0,0 +1,154 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+	bucketclientset "sigs.k8s.io/container-object-storage-interface-api/client/clientset/versioned"
+	bucketfake "sigs.k8s.io/container-object-storage-interface-api/client/clientset/versioned/fake"
+)
+
+func TestResolveBucketClaim(t *testing.T) {
+	claim := func(namespace, name string) *v1alpha1.BucketClaim {
+		return &v1alpha1.BucketClaim{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+	}
+	grant := func(namespace, from, to string) *v1alpha1.BucketClaimGrant {
+		return &v1alpha1.BucketClaimGrant{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "grant"},
+			Spec: v1alpha1.BucketClaimGrantSpec{
+				From: []v1alpha1.BucketClaimGrantFrom{{Namespace: from}},
+				To:   []v1alpha1.BucketClaimGrantTo{{Name: to}},
+			},
+		}
+	}
+	access := func(claimNamespace, claimName string) *v1alpha1.BucketAccess {
+		return &v1alpha1.BucketAccess{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "access"},
+			Spec: v1alpha1.BucketAccessSpec{
+				BucketClaimNamespace: claimNamespace,
+				BucketClaimName:      claimName,
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		namespaces []string
+		selectors  map[ResourceName]WatchSelector
+		// unsynced leaves the informer caches unsynced
+		unsynced bool
+		// cached objects are only in the informer caches, live objects only
+		// on the API server
+		cached      []runtime.Object
+		live        []runtime.Object
+		access      *v1alpha1.BucketAccess
+		wantErr     error
+		wantMissing bool
+	}{
+		{
+			name:   "claim in the same namespace from the cache",
+			cached: []runtime.Object{claim("app", "claim")},
+			access: access("", "claim"),
+		},
+		{
+			name:   "granted claim from the cache",
+			cached: []runtime.Object{claim("data", "claim"), grant("data", "app", "claim")},
+			access: access("data", "claim"),
+		},
+		{
+			name:    "claim granted only on the API server is not seen by an unscoped controller",
+			live:    []runtime.Object{claim("data", "claim"), grant("data", "app", "claim")},
+			access:  access("data", "claim"),
+			wantErr: ErrBucketClaimNotGranted,
+		},
+		{
+			name:     "granted claim before the caches have synced",
+			unsynced: true,
+			live:     []runtime.Object{claim("data", "claim"), grant("data", "app", "claim")},
+			access:   access("data", "claim"),
+		},
+		{
+			name:       "granted claim outside of the namespaces",
+			namespaces: []string{"app"},
+			live:       []runtime.Object{claim("data", "claim"), grant("data", "app", "claim")},
+			access:     access("data", "claim"),
+		},
+		{
+			name:       "claim outside of the namespaces not granted",
+			namespaces: []string{"app"},
+			live:       []runtime.Object{claim("data", "claim"), grant("data", "other", "claim")},
+			access:     access("data", "claim"),
+			wantErr:    ErrBucketClaimNotGranted,
+		},
+		{
+			name:        "granted claim outside of the namespaces not found",
+			namespaces:  []string{"app"},
+			live:        []runtime.Object{grant("data", "app", "")},
+			access:      access("data", "claim"),
+			wantMissing: true,
+		},
+		{
+			name:      "granted claim filtered by selectors",
+			selectors: map[ResourceName]WatchSelector{BucketClaimResourceName: {LabelSelector: "team=app"}},
+			cached:    []runtime.Object{grant("data", "app", "claim")},
+			live:      []runtime.Object{claim("data", "claim")},
+			access:    access("data", "claim"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewObjectStorageControllerWithClientset("id", "lock", 1, workqueue.DefaultControllerRateLimiter(), nil, bucketfake.NewSimpleClientset(tt.live...))
+			if err != nil {
+				t.Fatal(err)
+			}
+			c.Namespaces = tt.namespaces
+			c.Selectors = tt.selectors
+			if !tt.unsynced {
+				c.InformerFactory().InformerFor(&v1alpha1.BucketClaim{}, func(bucketclientset.Interface, time.Duration) cache.SharedIndexInformer {
+					return syncedInformer{cache.NewSharedIndexInformer(nil, &v1alpha1.BucketClaim{}, 0, namespaceIndexers())}
+				})
+				c.InformerFactory().InformerFor(&v1alpha1.BucketClaimGrant{}, func(bucketclientset.Interface, time.Duration) cache.SharedIndexInformer {
+					return syncedInformer{cache.NewSharedIndexInformer(nil, &v1alpha1.BucketClaimGrant{}, 0, namespaceIndexers())}
+				})
+			}
+			for _, obj := range tt.cached {
+				var err error
+				switch obj.(type) {
+				case *v1alpha1.BucketClaim:
+					err = BucketClaimResource.Informer(c).GetIndexer().Add(obj)
+				case *v1alpha1.BucketClaimGrant:
+					err = BucketClaimGrantResource.Informer(c).GetIndexer().Add(obj)
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			got, err := c.ResolveBucketClaim(context.Background(), tt.access)
+			switch {
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+			case tt.wantMissing:
+				if !apierrors.IsNotFound(err) {
+					t.Fatalf("expected not found, got %v", err)
+				}
+			case err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case got.Name != tt.access.Spec.BucketClaimName:
+				t.Errorf("expected claim %s, got %s", tt.access.Spec.BucketClaimName, got.Name)
+			}
+		})
+	}
+}
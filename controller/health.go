@@ -0,0 +1,184 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+)
+
+// leaderHealthzTimeout is the time past lease expiry after which /healthz fails
+// if the controller still believes it is the leader
+const leaderHealthzTimeout = 20 * time.Second
+
+type watchedResource struct {
+	name     string
+	informer cache.SharedIndexInformer
+}
+
+func (c *ObjectStorageController) addWatchedResource(name string, informer cache.SharedIndexInformer) {
+	c.watchedLock.Lock()
+	defer c.watchedLock.Unlock()
+	c.watched = append(c.watched, watchedResource{name: name, informer: informer})
+}
+
+func (c *ObjectStorageController) watchedResources() []watchedResource {
+	c.watchedLock.RLock()
+	defer c.watchedLock.RUnlock()
+	return append([]watchedResource{}, c.watched...)
+}
+
+func (c *ObjectStorageController) setElecting() {
+	c.watchedLock.Lock()
+	defer c.watchedLock.Unlock()
+	c.electing = true
+}
+
+func (c *ObjectStorageController) setLeading(leading bool) {
+	c.watchedLock.Lock()
+	defer c.watchedLock.Unlock()
+	c.leading = leading
+}
+
+// standby returns true if the controller takes part in leader election but
+// is not the leader
+func (c *ObjectStorageController) standby() bool {
+	c.watchedLock.RLock()
+	defer c.watchedLock.RUnlock()
+	return c.electing && !c.leading
+}
+
+// SyncStatus returns whether the cache of each watched resource has synced,
+// keyed by resource name. It is empty until the controller starts leading.
+func (c *ObjectStorageController) SyncStatus() map[string]bool {
+	status := map[string]bool{}
+	for _, r := range c.watchedResources() {
+		status[r.name] = r.informer.HasSynced()
+	}
+	return status
+}
+
+// HasSynced returns true once the controller is running and the caches of all
+// watched resources have synced
+func (c *ObjectStorageController) HasSynced() bool {
+	resources := c.watchedResources()
+	if len(resources) == 0 {
+		return false
+	}
+	for _, r := range resources {
+		if !r.informer.HasSynced() {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *ObjectStorageController) healthz(w http.ResponseWriter, r *http.Request) {
+	if err := c.leaderHealthz.Check(r); err != nil {
+		http.Error(w, fmt.Sprintf("leader election: %v", err), http.StatusInternalServerError)
+		return
+	}
+	fmt.Fprint(w, "ok")
+}
+
+// readyz reports whether all watched caches have synced. The status of each
+// resource is included in the response when the verbose query parameter is set,
+// and a single resource can be checked at /readyz/<resource>. Replicas that
+// wait to become the leader watch nothing and are reported as ready, so that
+// they do not hold up rollouts.
+func (c *ObjectStorageController) readyz(w http.ResponseWriter, r *http.Request) {
+	name := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, "/readyz"), "/")
+	if name == "" && c.standby() {
+		if _, verbose := r.URL.Query()["verbose"]; verbose {
+			fmt.Fprintln(w, "[+]standby, waiting to become leader")
+			return
+		}
+		fmt.Fprint(w, "ok")
+		return
+	}
+	status := c.SyncStatus()
+
+	if name != "" {
+		synced, ok := status[name]
+		switch {
+		case !ok:
+			http.Error(w, fmt.Sprintf("resource %q is not watched", name), http.StatusNotFound)
+		case !synced:
+			http.Error(w, fmt.Sprintf("%s not synced", name), http.StatusServiceUnavailable)
+		default:
+			fmt.Fprint(w, "ok")
+		}
+		return
+	}
+
+	var out bytes.Buffer
+	ready := c.HasSynced()
+	if len(status) == 0 {
+		fmt.Fprintln(&out, "[-]controller not started")
+	}
+	for _, res := range c.watchedResources() {
+		if status[res.name] {
+			fmt.Fprintf(&out, "[+]%s synced\n", res.name)
+		} else {
+			fmt.Fprintf(&out, "[-]%s not synced\n", res.name)
+		}
+	}
+
+	if !ready {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		out.WriteTo(w)
+		return
+	}
+	if _, verbose := r.URL.Query()["verbose"]; verbose {
+		out.WriteTo(w)
+		return
+	}
+	fmt.Fprint(w, "ok")
+}
+
+// serve starts the opt-in HTTP endpoints of the controller. Metrics and health
+// checks share a server if they are configured with the same address.
+func (c *ObjectStorageController) serve(ctx context.Context) {
+	muxes := map[string]*http.ServeMux{}
+	muxFor := func(addr string) *http.ServeMux {
+		if _, ok := muxes[addr]; !ok {
+			muxes[addr] = http.NewServeMux()
+		}
+		return muxes[addr]
+	}
+
+	if c.MetricsAddress != "" {
+		muxFor(c.MetricsAddress).Handle("/metrics", metricsHandler())
+	}
+	if c.HealthAddress != "" {
+		mux := muxFor(c.HealthAddress)
+		mux.HandleFunc("/healthz", c.healthz)
+		mux.HandleFunc("/readyz", c.readyz)
+		mux.HandleFunc("/readyz/", c.readyz)
+	}
+
+	for addr, mux := range muxes {
+		go serveHTTP(ctx, addr, mux)
+	}
+}
+
+// serveHTTP serves handler on addr until ctx is done
+func serveHTTP(ctx context.Context, addr string, handler http.Handler) {
+	server := &http.Server{Addr: addr, Handler: handler}
+	go func() {
+		<-ctx.Done()
+		if err := server.Shutdown(context.Background()); err != nil {
+			klog.ErrorS(err, "failed to shutdown http server", "address", addr)
+		}
+	}()
+
+	klog.V(2).InfoS("serving http endpoints", "address", addr)
+	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		klog.ErrorS(err, "http server failed", "address", addr)
+	}
+}
@@ -1,8 +1,6 @@
 package controller
 
 import (
-	"context"
-
 	// storage
 	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
 	bucketclientset "sigs.k8s.io/container-object-storage-interface-api/client/clientset/versioned"
@@ -19,67 +17,70 @@ type GenericListener interface {
 	InitializeEventRecorder(record.EventRecorder)
 }
 
-type BucketListener interface {
+// ListerListener is a listener that reads COSI objects from the controller's
+// shared informer caches instead of querying the API server
+type ListerListener interface {
 	GenericListener
 
-	Add(ctx context.Context, b *v1alpha1.Bucket) error
-	Update(ctx context.Context, old *v1alpha1.Bucket, new *v1alpha1.Bucket) error
-	Delete(ctx context.Context, b *v1alpha1.Bucket) error
+	InitializeListers(*Listers)
+}
+
+type BucketListener interface {
+	GenericListener
+	Listener[*v1alpha1.Bucket]
 }
 
+// AddBucketListener sets the listener for Buckets. Like AddListener, it replaces
+// the listener added before it.
 func (c *ObjectStorageController) AddBucketListener(b BucketListener) {
-	c.initialized = true
 	c.BucketListener = b
+	AddListener[*v1alpha1.Bucket](c, BucketResource, b)
 }
 
 type BucketClaimListener interface {
 	GenericListener
-
-	Add(ctx context.Context, b *v1alpha1.BucketClaim) error
-	Update(ctx context.Context, old *v1alpha1.BucketClaim, new *v1alpha1.BucketClaim) error
-	Delete(ctx context.Context, b *v1alpha1.BucketClaim) error
+	Listener[*v1alpha1.BucketClaim]
 }
 
+// AddBucketClaimListener sets the listener for BucketClaims. Like AddListener, it replaces
+// the listener added before it.
 func (c *ObjectStorageController) AddBucketClaimListener(b BucketClaimListener) {
-	c.initialized = true
 	c.BucketClaimListener = b
+	AddListener[*v1alpha1.BucketClaim](c, BucketClaimResource, b)
 }
 
 type BucketAccessListener interface {
 	GenericListener
-
-	Add(ctx context.Context, b *v1alpha1.BucketAccess) error
-	Update(ctx context.Context, old *v1alpha1.BucketAccess, new *v1alpha1.BucketAccess) error
-	Delete(ctx context.Context, b *v1alpha1.BucketAccess) error
+	Listener[*v1alpha1.BucketAccess]
 }
 
+// AddBucketAccessListener sets the listener for BucketAccesses. Like AddListener, it replaces
+// the listener added before it.
 func (c *ObjectStorageController) AddBucketAccessListener(b BucketAccessListener) {
-	c.initialized = true
 	c.BucketAccessListener = b
+	AddListener[*v1alpha1.BucketAccess](c, BucketAccessResource, b)
 }
 
 type BucketClassListener interface {
 	GenericListener
-
-	Add(ctx context.Context, b *v1alpha1.BucketClass) error
-	Update(ctx context.Context, old *v1alpha1.BucketClass, new *v1alpha1.BucketClass) error
-	Delete(ctx context.Context, b *v1alpha1.BucketClass) error
+	Listener[*v1alpha1.BucketClass]
 }
 
+// AddBucketClassListener sets the listener for BucketClasses. Like AddListener, it replaces
+// the listener added before it.
 func (c *ObjectStorageController) AddBucketClassListener(b BucketClassListener) {
-	c.initialized = true
 	c.BucketClassListener = b
+	AddListener[*v1alpha1.BucketClass](c, BucketClassResource, b)
 }
 
 type BucketAccessClassListener interface {
 	GenericListener
-
-	Add(ctx context.Context, b *v1alpha1.BucketAccessClass) error
-	Update(ctx context.Context, old *v1alpha1.BucketAccessClass, new *v1alpha1.BucketAccessClass) error
-	Delete(ctx context.Context, b *v1alpha1.BucketAccessClass) error
+	Listener[*v1alpha1.BucketAccessClass]
 }
 
+// AddBucketAccessClassListener sets the listener for BucketAccessClasses. Like AddListener, it replaces
+// the listener added before it.
 func (c *ObjectStorageController) AddBucketAccessClassListener(b BucketAccessClassListener) {
-	c.initialized = true
 	c.BucketAccessClassListener = b
+	AddListener[*v1alpha1.BucketAccessClass](c, BucketAccessClassResource, b)
 }
@@ -0,0 +1,236 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubeinformers "k8s.io/client-go/informers"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	kubeclientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+	bucketclientset "sigs.k8s.io/container-object-storage-interface-api/client/clientset/versioned"
+	objectstorageinformers "sigs.k8s.io/container-object-storage-interface-api/client/informers/externalversions/objectstorage/v1alpha1"
+)
+
+// Listener handles the events of a watched resource with objects of type T.
+// Listeners that also implement GenericListener or ListerListener are
+// initialized with the clients and listers of the controller before they
+// receive events.
+type Listener[T runtime.Object] interface {
+	Add(ctx context.Context, obj T) error
+	Update(ctx context.Context, old, new T) error
+	Delete(ctx context.Context, obj T) error
+}
+
+// ListenerFuncs implements Listener with functions. Events for which no
+// function is set are ignored.
+type ListenerFuncs[T runtime.Object] struct {
+	AddFunc    func(ctx context.Context, obj T) error
+	UpdateFunc func(ctx context.Context, old, new T) error
+	DeleteFunc func(ctx context.Context, obj T) error
+}
+
+func (f ListenerFuncs[T]) Add(ctx context.Context, obj T) error {
+	if f.AddFunc == nil {
+		return nil
+	}
+	return f.AddFunc(ctx, obj)
+}
+
+func (f ListenerFuncs[T]) Update(ctx context.Context, old, new T) error {
+	if f.UpdateFunc == nil {
+		return nil
+	}
+	return f.UpdateFunc(ctx, old, new)
+}
+
+func (f ListenerFuncs[T]) Delete(ctx context.Context, obj T) error {
+	if f.DeleteFunc == nil {
+		return nil
+	}
+	return f.DeleteFunc(ctx, obj)
+}
+
+// Resource is a resource with objects of type T that listeners can be added for
+type Resource[T runtime.Object] struct {
+	// Name identifies the resource in metrics, health checks and logs
+	Name string
+
+	// Informer returns the shared informer that watches the resource. The
+	// informers of the resources in this package are created on first use,
+	// scoped by the Selectors and Namespaces set at that time.
+	Informer func(c *ObjectStorageController) cache.SharedIndexInformer
+}
+
+// COSI resources
+var (
+	BucketResource = Resource[*v1alpha1.Bucket]{
+		Name: string(BucketResourceName),
+		Informer: func(c *ObjectStorageController) cache.SharedIndexInformer {
+			return c.informer(&v1alpha1.Bucket{}, func(client bucketclientset.Interface, resync time.Duration) cache.SharedIndexInformer {
+				return objectstorageinformers.NewFilteredBucketInformer(client, resync, driverIndexers(), c.tweakListOptions(BucketResourceName))
+			})
+		},
+	}
+	BucketClaimResource = Resource[*v1alpha1.BucketClaim]{
+		Name: string(BucketClaimResourceName),
+		Informer: func(c *ObjectStorageController) cache.SharedIndexInformer {
+			return c.informer(&v1alpha1.BucketClaim{}, func(client bucketclientset.Interface, resync time.Duration) cache.SharedIndexInformer {
+				return objectstorageinformers.NewFilteredBucketClaimInformer(client, c.watchNamespace(), resync, namespaceIndexers(), c.tweakListOptions(BucketClaimResourceName))
+			})
+		},
+	}
+	BucketClaimGrantResource = Resource[*v1alpha1.BucketClaimGrant]{
+		Name: string(BucketClaimGrantResourceName),
+		Informer: func(c *ObjectStorageController) cache.SharedIndexInformer {
+			return c.informer(&v1alpha1.BucketClaimGrant{}, func(client bucketclientset.Interface, resync time.Duration) cache.SharedIndexInformer {
+				return objectstorageinformers.NewFilteredBucketClaimGrantInformer(client, c.watchNamespace(), resync, namespaceIndexers(), c.tweakListOptions(BucketClaimGrantResourceName))
+			})
+		},
+	}
+	BucketAccessResource = Resource[*v1alpha1.BucketAccess]{
+		Name: string(BucketAccessResourceName),
+		Informer: func(c *ObjectStorageController) cache.SharedIndexInformer {
+			return c.informer(&v1alpha1.BucketAccess{}, func(client bucketclientset.Interface, resync time.Duration) cache.SharedIndexInformer {
+				return objectstorageinformers.NewFilteredBucketAccessInformer(client, c.watchNamespace(), resync, namespaceIndexers(), c.tweakListOptions(BucketAccessResourceName))
+			})
+		},
+	}
+	BucketClassResource = Resource[*v1alpha1.BucketClass]{
+		Name: string(BucketClassResourceName),
+		Informer: func(c *ObjectStorageController) cache.SharedIndexInformer {
+			return c.informer(&v1alpha1.BucketClass{}, func(client bucketclientset.Interface, resync time.Duration) cache.SharedIndexInformer {
+				return objectstorageinformers.NewFilteredBucketClassInformer(client, resync, driverIndexers(), c.tweakListOptions(BucketClassResourceName))
+			})
+		},
+	}
+	BucketAccessClassResource = Resource[*v1alpha1.BucketAccessClass]{
+		Name: string(BucketAccessClassResourceName),
+		Informer: func(c *ObjectStorageController) cache.SharedIndexInformer {
+			return c.informer(&v1alpha1.BucketAccessClass{}, func(client bucketclientset.Interface, resync time.Duration) cache.SharedIndexInformer {
+				return objectstorageinformers.NewFilteredBucketAccessClassInformer(client, resync, driverIndexers(), c.tweakListOptions(BucketAccessClassResourceName))
+			})
+		},
+	}
+)
+
+// Core resources that sidecars commonly watch alongside COSI resources
+var (
+	SecretResource = Resource[*v1.Secret]{
+		Name: string(SecretResourceName),
+		Informer: func(c *ObjectStorageController) cache.SharedIndexInformer {
+			return c.kubeInformer(&v1.Secret{}, func(client kubeclientset.Interface, resync time.Duration) cache.SharedIndexInformer {
+				return coreinformers.NewFilteredSecretInformer(client, c.watchNamespace(), resync, namespaceIndexers(), c.tweakListOptions(SecretResourceName))
+			})
+		},
+	}
+	ServiceAccountResource = Resource[*v1.ServiceAccount]{
+		Name: string(ServiceAccountResourceName),
+		Informer: func(c *ObjectStorageController) cache.SharedIndexInformer {
+			return c.kubeInformer(&v1.ServiceAccount{}, func(client kubeclientset.Interface, resync time.Duration) cache.SharedIndexInformer {
+				return coreinformers.NewFilteredServiceAccountInformer(client, c.watchNamespace(), resync, namespaceIndexers(), c.tweakListOptions(ServiceAccountResourceName))
+			})
+		},
+	}
+	NamespaceResource = Resource[*v1.Namespace]{
+		Name: string(NamespaceResourceName),
+		Informer: func(c *ObjectStorageController) cache.SharedIndexInformer {
+			return c.kubeInformer(&v1.Namespace{}, func(client kubeclientset.Interface, resync time.Duration) cache.SharedIndexInformer {
+				return coreinformers.NewFilteredNamespaceInformer(client, resync, namespaceIndexers(), c.tweakListOptions(NamespaceResourceName))
+			})
+		},
+	}
+)
+
+// registration is a listener added for a resource, with its events converted
+// to the untyped operations of the work queue
+type registration struct {
+	name     string
+	informer func(c *ObjectStorageController) cache.SharedIndexInformer
+	listener interface{}
+
+	add    addFunc
+	update updateFunc
+	delete deleteFunc
+}
+
+func newRegistration[T runtime.Object](r Resource[T], l Listener[T]) registration {
+	return registration{
+		name:     r.Name,
+		informer: r.Informer,
+		listener: l,
+		add: func(ctx context.Context, obj interface{}) error {
+			return l.Add(ctx, obj.(T))
+		},
+		update: func(ctx context.Context, old, new interface{}) error {
+			return l.Update(ctx, old.(T), new.(T))
+		},
+		delete: func(ctx context.Context, obj interface{}) error {
+			return l.Delete(ctx, obj.(T))
+		},
+	}
+}
+
+// AddListener adds a listener for the objects of resource r. A resource has at
+// most one listener: the last one added with AddListener or with the typed
+// Add*Listener methods, in whatever order they are called.
+func AddListener[T runtime.Object](c *ObjectStorageController, r Resource[T], l Listener[T]) {
+	c.initialized = true
+	c.registrations = append(c.registrations, newRegistration(r, l))
+}
+
+// listenerRegistrations returns the listeners to run. Resources with a typed
+// field come first, in the order of the fields, followed by the other resources
+// in the order they were added with AddListener. A listener assigned directly
+// to a typed field counts as added before all others, so it is replaced by any
+// listener added for the same resource with AddListener or an Add*Listener
+// method.
+func (c *ObjectStorageController) listenerRegistrations() []registration {
+	var all []registration
+	if c.BucketListener != nil {
+		all = append(all, newRegistration[*v1alpha1.Bucket](BucketResource, c.BucketListener))
+	}
+	if c.BucketClaimListener != nil {
+		all = append(all, newRegistration[*v1alpha1.BucketClaim](BucketClaimResource, c.BucketClaimListener))
+	}
+	if c.BucketAccessListener != nil {
+		all = append(all, newRegistration[*v1alpha1.BucketAccess](BucketAccessResource, c.BucketAccessListener))
+	}
+	if c.BucketClassListener != nil {
+		all = append(all, newRegistration[*v1alpha1.BucketClass](BucketClassResource, c.BucketClassListener))
+	}
+	if c.BucketAccessClassListener != nil {
+		all = append(all, newRegistration[*v1alpha1.BucketAccessClass](BucketAccessClassResource, c.BucketAccessClassListener))
+	}
+
+	index := map[string]int{}
+	for i, r := range all {
+		index[r.name] = i
+	}
+	for _, r := range c.registrations {
+		if i, ok := index[r.name]; ok {
+			all[i] = r
+			continue
+		}
+		index[r.name] = len(all)
+		all = append(all, r)
+	}
+	return all
+}
+
+// KubeInformerFactory returns the shared informer factory for core resources.
+// It is created on first use with ResyncPeriod as the default resync period.
+// Like with InformerFactory, informers must be requested through the Informer
+// of a Resource to be scoped by Selectors and Namespaces.
+func (c *ObjectStorageController) KubeInformerFactory() kubeinformers.SharedInformerFactory {
+	c.factoryLock.Lock()
+	defer c.factoryLock.Unlock()
+	if c.kubeInformerFactory == nil {
+		c.kubeInformerFactory = kubeinformers.NewSharedInformerFactory(c.kubeClient, c.ResyncPeriod)
+	}
+	return c.kubeInformerFactory
+}
@@ -0,0 +1,169 @@
+package controller
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	kubeclientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+	bucketclientset "sigs.k8s.io/container-object-storage-interface-api/client/clientset/versioned"
+	bucketfake "sigs.k8s.io/container-object-storage-interface-api/client/clientset/versioned/fake"
+)
+
+// namedListener is a BucketListener and BucketClaimListener that is told apart
+// by its name
+type namedListener struct {
+	name string
+}
+
+func (l *namedListener) InitializeKubeClient(kubeclientset.Interface)     {}
+func (l *namedListener) InitializeBucketClient(bucketclientset.Interface) {}
+func (l *namedListener) InitializeEventRecorder(record.EventRecorder)     {}
+
+func bucketListener(name string) *namedBucketListener {
+	return &namedBucketListener{namedListener{name}, ListenerFuncs[*v1alpha1.Bucket]{}}
+}
+
+type namedBucketListener struct {
+	namedListener
+	ListenerFuncs[*v1alpha1.Bucket]
+}
+
+func claimListener(name string) *namedClaimListener {
+	return &namedClaimListener{namedListener{name}, ListenerFuncs[*v1alpha1.BucketClaim]{}}
+}
+
+type namedClaimListener struct {
+	namedListener
+	ListenerFuncs[*v1alpha1.BucketClaim]
+}
+
+func TestListenerRegistrations(t *testing.T) {
+	tests := []struct {
+		name     string
+		register func(c *ObjectStorageController)
+		// want are the resource and listener names of the registrations
+		want []string
+	}{
+		{
+			name: "typed method only",
+			register: func(c *ObjectStorageController) {
+				c.AddBucketListener(bucketListener("typed"))
+			},
+			want: []string{"Buckets=typed"},
+		},
+		{
+			name: "typed method then AddListener",
+			register: func(c *ObjectStorageController) {
+				c.AddBucketListener(bucketListener("typed"))
+				AddListener[*v1alpha1.Bucket](c, BucketResource, bucketListener("generic"))
+			},
+			want: []string{"Buckets=generic"},
+		},
+		{
+			name: "AddListener then typed method",
+			register: func(c *ObjectStorageController) {
+				AddListener[*v1alpha1.Bucket](c, BucketResource, bucketListener("generic"))
+				c.AddBucketListener(bucketListener("typed"))
+			},
+			want: []string{"Buckets=typed"},
+		},
+		{
+			name: "field assigned after AddListener",
+			register: func(c *ObjectStorageController) {
+				AddListener[*v1alpha1.Bucket](c, BucketResource, bucketListener("generic"))
+				c.BucketListener = bucketListener("field")
+			},
+			want: []string{"Buckets=generic"},
+		},
+		{
+			name: "field assigned only",
+			register: func(c *ObjectStorageController) {
+				c.BucketListener = bucketListener("field")
+			},
+			want: []string{"Buckets=field"},
+		},
+		{
+			name: "typed resources come first",
+			register: func(c *ObjectStorageController) {
+				AddListener[*v1alpha1.BucketClaim](c, BucketClaimResource, claimListener("generic"))
+				c.AddBucketListener(bucketListener("first"))
+				c.AddBucketClaimListener(claimListener("typed"))
+				AddListener[*v1alpha1.Bucket](c, BucketResource, bucketListener("last"))
+			},
+			want: []string{"Buckets=last", "BucketClaims=typed"},
+		},
+		{
+			name: "fields come first",
+			register: func(c *ObjectStorageController) {
+				AddListener[*v1alpha1.BucketClaim](c, BucketClaimResource, claimListener("generic"))
+				c.BucketListener = bucketListener("field")
+			},
+			want: []string{"Buckets=field", "BucketClaims=generic"},
+		},
+		{
+			name: "other resources follow in the order they were added",
+			register: func(c *ObjectStorageController) {
+				AddListener[*v1alpha1.BucketClaim](c, Resource[*v1alpha1.BucketClaim]{Name: "Other"}, claimListener("other"))
+				c.AddBucketClaimListener(claimListener("typed"))
+			},
+			want: []string{"BucketClaims=typed", "Other=other"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &ObjectStorageController{}
+			test.register(c)
+
+			got := []string{}
+			for _, r := range c.listenerRegistrations() {
+				var name string
+				switch l := r.listener.(type) {
+				case *namedBucketListener:
+					name = l.name
+				case *namedClaimListener:
+					name = l.name
+				}
+				got = append(got, r.name+"="+name)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got registrations %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestStartOnlyRequestedInformers(t *testing.T) {
+	c, err := NewObjectStorageControllerWithClientset("test", "test", 1, workqueue.DefaultControllerRateLimiter(), nil, bucketfake.NewSimpleClientset())
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.AddBucketListener(bucketListener("bucket"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go c.runController(ctx)
+
+	var started map[reflect.Type]bool
+	if err := wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		started = c.InformerFactory().WaitForCacheSync(ctx.Done())
+		return len(started) > 0, nil
+	}); err != nil {
+		t.Fatalf("no informer was started: %v", err)
+	}
+
+	want := map[reflect.Type]bool{reflect.TypeOf(&v1alpha1.Bucket{}): true}
+	if !reflect.DeepEqual(started, want) {
+		t.Errorf("got started informers %v, want %v", started, want)
+	}
+	if c.startedKubeInformerFactory() != nil {
+		t.Errorf("got a kube informer factory, want none")
+	}
+}
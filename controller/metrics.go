@@ -0,0 +1,97 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	// registers the workqueue metrics provider with the controller-runtime registry
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	metricsNamespace = "cosi"
+	metricsSubsystem = "controller"
+
+	// workqueue metrics are labelled with the queue name, so it must not
+	// depend on the leader lock name or the identity of the replica
+	queueName = "objectstorage-controller"
+
+	opAdd    = "add"
+	opUpdate = "update"
+	opDelete = "delete"
+
+	resultSuccess  = "success"
+	resultError    = "error"
+	resultRequeued = "requeued"
+)
+
+var (
+	operationsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "operations_total",
+		Help:      "Total number of listener operations by resource, operation and result",
+	}, []string{"resource", "operation", "result"})
+
+	operationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "operation_duration_seconds",
+		Help:      "Time taken by listener operations by resource and operation",
+		Buckets:   prometheus.ExponentialBuckets(0.005, 2, 14),
+	}, []string{"resource", "operation"})
+
+	retriesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "retries_total",
+		Help:      "Total number of listener operations requeued after an error by resource and operation",
+	}, []string{"resource", "operation"})
+
+	droppedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "dropped_total",
+		Help:      "Total number of listener operations dropped without being retried by resource, operation and reason",
+	}, []string{"resource", "operation", "reason"})
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(operationsTotal, operationDuration, retriesTotal, droppedTotal)
+}
+
+// MetricsRegistry returns the registry that the controller metrics and the
+// workqueue metrics are registered with. Listeners may register their own
+// collectors with it to have them served on MetricsAddress.
+func MetricsRegistry() ctrlmetrics.RegistererGatherer {
+	return ctrlmetrics.Registry
+}
+
+func observeOperation(resource, operation string, start time.Time, err error) {
+	result := resultSuccess
+	var requeueAfter *RequeueAfterError
+	switch {
+	case errors.As(err, &requeueAfter) && requeueAfter.Err == nil:
+		result = resultRequeued
+	case err != nil:
+		result = resultError
+	}
+	operationsTotal.WithLabelValues(resource, operation, result).Inc()
+	operationDuration.WithLabelValues(resource, operation).Observe(time.Since(start).Seconds())
+}
+
+func observeRetry(resource, operation string) {
+	retriesTotal.WithLabelValues(resource, operation).Inc()
+}
+
+func observeDrop(resource, operation, reason string) {
+	droppedTotal.WithLabelValues(resource, operation, reason).Inc()
+}
+
+func metricsHandler() http.Handler {
+	return promhttp.HandlerFor(ctrlmetrics.Registry, promhttp.HandlerOpts{})
+}
@@ -0,0 +1,41 @@
+package controller
+
+import (
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+)
+
+// EffectiveBucketQuota returns the quota to set on the Bucket provisioned for
+// claim. Limits that the claim does not set are taken from the DefaultQuota of
+// class. It returns nil if neither sets any limit.
+func EffectiveBucketQuota(claim *v1alpha1.BucketClaim, class *v1alpha1.BucketClass) *v1alpha1.BucketQuota {
+	quota := &v1alpha1.BucketQuota{}
+	if claim.Spec.Quota != nil {
+		quota = claim.Spec.Quota.DeepCopy()
+	}
+	if class != nil && class.DefaultQuota != nil {
+		if quota.MaxSize == nil && class.DefaultQuota.MaxSize != nil {
+			maxSize := class.DefaultQuota.MaxSize.DeepCopy()
+			quota.MaxSize = &maxSize
+		}
+		if quota.MaxObjects == nil && class.DefaultQuota.MaxObjects != nil {
+			maxObjects := *class.DefaultQuota.MaxObjects
+			quota.MaxObjects = &maxObjects
+		}
+	}
+
+	if quota.MaxSize == nil && quota.MaxObjects == nil {
+		return nil
+	}
+	return quota
+}
+
+// QuotaExceeded returns true if usage exceeds any of the limits of quota
+func QuotaExceeded(quota *v1alpha1.BucketQuota, usage *v1alpha1.BucketUsage) bool {
+	if quota == nil || usage == nil {
+		return false
+	}
+	if quota.MaxSize != nil && usage.Size.Cmp(*quota.MaxSize) > 0 {
+		return true
+	}
+	return quota.MaxObjects != nil && usage.ObjectCount > *quota.MaxObjects
+}
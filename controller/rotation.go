@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+)
+
+// NextRotationTime returns the time at which the credentials of access are due
+// for rotation according to the RotationPolicy of class. It returns false if
+// the credentials are not rotated or have not been minted yet.
+func NextRotationTime(access *v1alpha1.BucketAccess, class *v1alpha1.BucketAccessClass) (time.Time, bool) {
+	if class.RotationPolicy == nil || access.Status.LastRotationTime == nil {
+		return time.Time{}, false
+	}
+	return access.Status.LastRotationTime.Add(class.RotationPolicy.Interval.Duration), true
+}
+
+// RotationDue returns true if the credentials of access should be rotated at now
+func RotationDue(access *v1alpha1.BucketAccess, class *v1alpha1.BucketAccessClass, now time.Time) bool {
+	next, ok := NextRotationTime(access, class)
+	return ok && !now.Before(next)
+}
+
+// PreviousCredentialsExpired returns true if the credentials replaced by the
+// last rotation are past their grace period and should be revoked
+func PreviousCredentialsExpired(access *v1alpha1.BucketAccess, now time.Time) bool {
+	expiry := access.Status.PreviousCredentialsExpiryTime
+	return expiry != nil && !now.Before(expiry.Time)
+}
+
+// MarkCredentialsRotated records in the status of access that new credentials
+// were minted at now. If previous credentials exist, they remain valid for the
+// grace period of class.
+func MarkCredentialsRotated(access *v1alpha1.BucketAccess, class *v1alpha1.BucketAccessClass, now time.Time) {
+	if access.Status.CredentialGeneration > 0 {
+		expiry := now
+		if class.RotationPolicy != nil && class.RotationPolicy.GracePeriod != nil {
+			expiry = now.Add(class.RotationPolicy.GracePeriod.Duration)
+		}
+		access.Status.PreviousCredentialsExpiryTime = &metav1.Time{Time: expiry}
+	}
+	access.Status.CredentialGeneration++
+	access.Status.LastRotationTime = &metav1.Time{Time: now}
+}
+
+// MarkPreviousCredentialsRevoked records in the status of access that the
+// credentials replaced by the last rotation were revoked
+func MarkPreviousCredentialsRevoked(access *v1alpha1.BucketAccess) {
+	access.Status.PreviousCredentialsExpiryTime = nil
+}
+
+// RequeueForRotation returns an error that requeues the BucketAccess when its
+// credentials are next due for rotation, or when the previous credentials are
+// due for revocation, whichever comes first. Listeners return it from Add and
+// Update once access has been granted. It returns nil if nothing is scheduled.
+func RequeueForRotation(access *v1alpha1.BucketAccess, class *v1alpha1.BucketAccessClass, now time.Time) error {
+	var next time.Time
+	if t, ok := NextRotationTime(access, class); ok {
+		next = t
+	}
+	if expiry := access.Status.PreviousCredentialsExpiryTime; expiry != nil && (next.IsZero() || expiry.Time.Before(next)) {
+		next = expiry.Time
+	}
+	if next.IsZero() {
+		return nil
+	}
+
+	after := next.Sub(now)
+	if after < 0 {
+		after = 0
+	}
+	return NewRequeueAfterError(nil, after)
+}
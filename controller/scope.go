@@ -0,0 +1,206 @@
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubeinformers "k8s.io/client-go/informers"
+	kubeclientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+	bucketclientset "sigs.k8s.io/container-object-storage-interface-api/client/clientset/versioned"
+)
+
+// DriverNameIndex indexes Buckets, BucketClasses and BucketAccessClasses by the
+// name of their driver
+const DriverNameIndex = "driverName"
+
+// ResourceName is the name of a resource defined in this package, as used in
+// Selectors. It is the Name of the Resource of the same name.
+type ResourceName string
+
+// Names of the resources defined in this package
+const (
+	BucketResourceName            ResourceName = "Buckets"
+	BucketClaimResourceName       ResourceName = "BucketClaims"
+	BucketClaimGrantResourceName  ResourceName = "BucketClaimGrants"
+	BucketAccessResourceName      ResourceName = "BucketAccesses"
+	BucketClassResourceName       ResourceName = "BucketClasses"
+	BucketAccessClassResourceName ResourceName = "BucketAccessClasses"
+	SecretResourceName            ResourceName = "Secrets"
+	ServiceAccountResourceName    ResourceName = "ServiceAccounts"
+	NamespaceResourceName         ResourceName = "Namespaces"
+)
+
+// WatchSelector restricts the objects of a watched resource on the API server
+type WatchSelector struct {
+	// LabelSelector selects objects by their labels
+	LabelSelector string
+
+	// FieldSelector selects objects by their fields. Like all custom
+	// resources, COSI resources only support metadata.name and
+	// metadata.namespace.
+	FieldSelector string
+}
+
+// defaultSelectors are the selectors of the resources that have no entry in
+// Selectors. Only Secrets labelled as credentials Secrets are cached, rather
+// than all Secrets in scope.
+var defaultSelectors = map[ResourceName]WatchSelector{
+	SecretResourceName: {LabelSelector: CredentialsSecretLabel},
+}
+
+// selector returns the selector of the resource with the given name
+func (c *ObjectStorageController) selector(name ResourceName) WatchSelector {
+	if s, ok := c.Selectors[name]; ok {
+		return s
+	}
+	return defaultSelectors[name]
+}
+
+// driverNameOf returns the driver name of obj, or false if obj has none
+func driverNameOf(obj interface{}) (string, bool) {
+	switch o := obj.(type) {
+	case *v1alpha1.Bucket:
+		return o.Spec.DriverName, true
+	case *v1alpha1.BucketClass:
+		return o.DriverName, true
+	case *v1alpha1.BucketAccessClass:
+		return o.DriverName, true
+	}
+	return "", false
+}
+
+func driverNameIndexFunc(obj interface{}) ([]string, error) {
+	name, ok := driverNameOf(obj)
+	if !ok || name == "" {
+		return nil, nil
+	}
+	return []string{name}, nil
+}
+
+// validateScope returns an error if a selector is set for an unknown resource
+// or does not parse
+func (c *ObjectStorageController) validateScope() error {
+	known := map[ResourceName]bool{}
+	for _, name := range []ResourceName{BucketResourceName, BucketClaimResourceName, BucketClaimGrantResourceName, BucketAccessResourceName, BucketClassResourceName, BucketAccessClassResourceName, SecretResourceName, ServiceAccountResourceName, NamespaceResourceName} {
+		known[name] = true
+	}
+
+	for name, s := range c.Selectors {
+		if !known[name] {
+			return fmt.Errorf("selector for unknown resource %q", name)
+		}
+		if _, err := labels.Parse(s.LabelSelector); err != nil {
+			return fmt.Errorf("invalid label selector for %s: %w", name, err)
+		}
+		if _, err := fields.ParseSelector(s.FieldSelector); err != nil {
+			return fmt.Errorf("invalid field selector for %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// watchNamespace returns the namespace that namespaced resources are watched in.
+// Several allowed namespaces are watched across all namespaces and filtered by
+// inScope.
+func (c *ObjectStorageController) watchNamespace() string {
+	if len(c.Namespaces) == 1 {
+		return c.Namespaces[0]
+	}
+	return metav1.NamespaceAll
+}
+
+// tweakListOptions returns a function that applies the selectors of the
+// resource with the given name to list and watch requests
+func (c *ObjectStorageController) tweakListOptions(name ResourceName) func(*metav1.ListOptions) {
+	s := c.selector(name)
+	return func(opts *metav1.ListOptions) {
+		opts.LabelSelector = s.LabelSelector
+		opts.FieldSelector = s.FieldSelector
+	}
+}
+
+// inScope returns false for objects outside of the allowed namespaces, and for
+// objects of other drivers than DriverName
+func (c *ObjectStorageController) inScope(obj interface{}) bool {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+
+	if m, ok := obj.(metav1.Object); ok && m.GetNamespace() != "" && len(c.Namespaces) > 0 {
+		allowed := false
+		for _, ns := range c.Namespaces {
+			if m.GetNamespace() == ns {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return false
+		}
+	}
+
+	if c.DriverName != "" {
+		if name, ok := driverNameOf(obj); ok && name != c.DriverName {
+			return false
+		}
+	}
+	return true
+}
+
+// namespaceIndexers returns the indexers of informers. Each informer gets its
+// own, as indexers added to an informer are added to the map it was created with.
+func namespaceIndexers() cache.Indexers {
+	return cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}
+}
+
+// driverIndexers returns the indexers of informers of resources with a driver
+func driverIndexers() cache.Indexers {
+	return cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc, DriverNameIndex: driverNameIndexFunc}
+}
+
+// informer returns the informer of obj from InformerFactory, created with
+// newFunc on first use. Informers created while the controller runs are
+// started right away.
+func (c *ObjectStorageController) informer(obj runtime.Object, newFunc func(bucketclientset.Interface, time.Duration) cache.SharedIndexInformer) cache.SharedIndexInformer {
+	informer := c.InformerFactory().InformerFor(obj, newFunc)
+	c.startInformers()
+	return informer
+}
+
+// kubeInformer is informer for KubeInformerFactory
+func (c *ObjectStorageController) kubeInformer(obj runtime.Object, newFunc func(kubeclientset.Interface, time.Duration) cache.SharedIndexInformer) cache.SharedIndexInformer {
+	informer := c.KubeInformerFactory().InformerFor(obj, newFunc)
+	c.startInformers()
+	return informer
+}
+
+// startInformers starts the informers that have not been started yet, once
+// the controller runs
+func (c *ObjectStorageController) startInformers() {
+	c.factoryLock.Lock()
+	defer c.factoryLock.Unlock()
+	if c.informersStop == nil {
+		return
+	}
+	if c.informerFactory != nil {
+		c.informerFactory.Start(c.informersStop)
+	}
+	if c.kubeInformerFactory != nil {
+		c.kubeInformerFactory.Start(c.informersStop)
+	}
+}
+
+// startedKubeInformerFactory returns KubeInformerFactory, or nil if no core
+// informer was requested
+func (c *ObjectStorageController) startedKubeInformerFactory() kubeinformers.SharedInformerFactory {
+	c.factoryLock.Lock()
+	defer c.factoryLock.Unlock()
+	return c.kubeInformerFactory
+}
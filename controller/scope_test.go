@@ -0,0 +1,107 @@
+package controller
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidateScope(t *testing.T) {
+	tests := []struct {
+		name      string
+		selectors map[ResourceName]WatchSelector
+		wantErr   bool
+	}{
+		{
+			name: "no selectors",
+		},
+		{
+			name: "valid selectors",
+			selectors: map[ResourceName]WatchSelector{
+				BucketAccessResourceName: {LabelSelector: "team=a"},
+				SecretResourceName:       {LabelSelector: CredentialsSecretLabel},
+				BucketClaimResourceName:  {FieldSelector: "metadata.namespace!=kube-system"},
+			},
+		},
+		{
+			name: "unknown resource",
+			selectors: map[ResourceName]WatchSelector{
+				"BucketAccess": {LabelSelector: "team=a"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid label selector",
+			selectors: map[ResourceName]WatchSelector{
+				BucketResourceName: {LabelSelector: "team in a"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid field selector",
+			selectors: map[ResourceName]WatchSelector{
+				BucketClassResourceName: {FieldSelector: "metadata.name"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &ObjectStorageController{Selectors: test.selectors}
+			if err := c.validateScope(); (err != nil) != test.wantErr {
+				t.Errorf("got error %v, want error %v", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestTweakListOptions(t *testing.T) {
+	tests := []struct {
+		name          string
+		selectors     map[ResourceName]WatchSelector
+		resource      ResourceName
+		labelSelector string
+		fieldSelector string
+	}{
+		{
+			name:     "no selector",
+			resource: BucketResourceName,
+		},
+		{
+			name:          "selector",
+			selectors:     map[ResourceName]WatchSelector{BucketResourceName: {LabelSelector: "team=a", FieldSelector: "metadata.name=b"}},
+			resource:      BucketResourceName,
+			labelSelector: "team=a",
+			fieldSelector: "metadata.name=b",
+		},
+		{
+			name:          "default Secret selector",
+			selectors:     map[ResourceName]WatchSelector{BucketResourceName: {LabelSelector: "team=a"}},
+			resource:      SecretResourceName,
+			labelSelector: CredentialsSecretLabel,
+		},
+		{
+			name:          "Secret selector overrides the default",
+			selectors:     map[ResourceName]WatchSelector{SecretResourceName: {LabelSelector: "team=a"}},
+			resource:      SecretResourceName,
+			labelSelector: "team=a",
+		},
+		{
+			name:      "empty Secret selector watches all Secrets",
+			selectors: map[ResourceName]WatchSelector{SecretResourceName: {}},
+			resource:  SecretResourceName,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &ObjectStorageController{Selectors: test.selectors}
+			opts := metav1.ListOptions{LabelSelector: "stale", FieldSelector: "stale"}
+			c.tweakListOptions(test.resource)(&opts)
+			if opts.LabelSelector != test.labelSelector || opts.FieldSelector != test.fieldSelector {
+				t.Errorf("got selectors %q and %q, want %q and %q", opts.LabelSelector, opts.FieldSelector, test.labelSelector, test.fieldSelector)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakeprovisioner
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"sigs.k8s.io/container-object-storage-interface-api/conformance"
+	cosi "sigs.k8s.io/container-object-storage-interface-spec"
+)
+
+// identityServer serves the Identity service of a Server
+type identityServer struct {
+	s *Server
+}
+
+func (i *identityServer) ProvisionerGetInfo(ctx context.Context, req *cosi.ProvisionerGetInfoRequest) (*cosi.ProvisionerGetInfoResponse, error) {
+	resp, err := i.s.serve(ctx, conformance.RPCGetInfo, req, func() (proto.Message, error) {
+		return &cosi.ProvisionerGetInfoResponse{Name: i.s.name}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*cosi.ProvisionerGetInfoResponse), nil
+}
+
+// provisionerServer serves the Provisioner service of a Server. The store
+// follows the behavior required by the spec, so that the fake can be used to
+// check that callers rely on nothing more.
+type provisionerServer struct {
+	s *Server
+}
+
+// ProvisionerCreateBucket is idempotent: creating a bucket that exists with the
+// same protocol and parameters returns its id, and creating one that exists
+// with others fails with AlreadyExists
+func (p *provisionerServer) ProvisionerCreateBucket(ctx context.Context, req *cosi.ProvisionerCreateBucketRequest) (*cosi.ProvisionerCreateBucketResponse, error) {
+	resp, err := p.s.serve(ctx, conformance.RPCCreateBucket, req, func() (proto.Message, error) {
+		if req.Name == "" {
+			return nil, status.Error(codes.InvalidArgument, "bucket name is required")
+		}
+		if req.Protocol == nil || req.Protocol.Type == nil {
+			return nil, status.Error(codes.InvalidArgument, "protocol is required")
+		}
+
+		if bucket := p.s.store.bucketByName(req.Name); bucket != nil {
+			if !proto.Equal(bucket.Protocol, req.Protocol) || !equalMaps(bucket.Parameters, req.Parameters) {
+				return nil, status.Errorf(codes.AlreadyExists, "bucket %s exists with different protocol or parameters", req.Name)
+			}
+			return &cosi.ProvisionerCreateBucketResponse{BucketId: bucket.ID}, nil
+		}
+
+		bucket := p.s.store.addBucket(req.Name, req.Protocol, req.Parameters)
+		return &cosi.ProvisionerCreateBucketResponse{BucketId: bucket.ID}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*cosi.ProvisionerCreateBucketResponse), nil
+}
+
+// ProvisionerDeleteBucket succeeds if the bucket does not exist. The accounts
+// of the bucket are revoked with it.
+func (p *provisionerServer) ProvisionerDeleteBucket(ctx context.Context, req *cosi.ProvisionerDeleteBucketRequest) (*cosi.ProvisionerDeleteBucketResponse, error) {
+	resp, err := p.s.serve(ctx, conformance.RPCDeleteBucket, req, func() (proto.Message, error) {
+		if req.BucketId == "" {
+			return nil, status.Error(codes.InvalidArgument, "bucket id is required")
+		}
+		p.s.store.deleteBucket(req.BucketId)
+		return &cosi.ProvisionerDeleteBucketResponse{}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*cosi.ProvisionerDeleteBucketResponse), nil
+}
+
+// ProvisionerGrantBucketAccess is idempotent: granting access to an account
+// name that already has access to the bucket with the same access policy and
+// parameters returns the same account id and credentials, and granting it with
+// others fails with AlreadyExists
+func (p *provisionerServer) ProvisionerGrantBucketAccess(ctx context.Context, req *cosi.ProvisionerGrantBucketAccessRequest) (*cosi.ProvisionerGrantBucketAccessResponse, error) {
+	resp, err := p.s.serve(ctx, conformance.RPCGrantBucketAccess, req, func() (proto.Message, error) {
+		if req.BucketId == "" {
+			return nil, status.Error(codes.InvalidArgument, "bucket id is required")
+		}
+		if req.AccountName == "" {
+			return nil, status.Error(codes.InvalidArgument, "account name is required")
+		}
+		if p.s.store.buckets[req.BucketId] == nil {
+			return nil, status.Errorf(codes.NotFound, "bucket %s not found", req.BucketId)
+		}
+
+		account := p.s.store.accountByName(req.BucketId, req.AccountName)
+		if account == nil {
+			account = p.s.store.addAccount(req.BucketId, req.AccountName, req.AccessPolicy, req.Parameters)
+		} else if account.AccessPolicy != req.AccessPolicy || !equalMaps(account.Parameters, req.Parameters) {
+			return nil, status.Errorf(codes.AlreadyExists, "account %s exists with different access policy or parameters", req.AccountName)
+		}
+		return &cosi.ProvisionerGrantBucketAccessResponse{
+			AccountId:   account.ID,
+			Credentials: account.Credentials,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*cosi.ProvisionerGrantBucketAccessResponse), nil
+}
+
+// ProvisionerRevokeBucketAccess succeeds if the account does not exist
+func (p *provisionerServer) ProvisionerRevokeBucketAccess(ctx context.Context, req *cosi.ProvisionerRevokeBucketAccessRequest) (*cosi.ProvisionerRevokeBucketAccessResponse, error) {
+	resp, err := p.s.serve(ctx, conformance.RPCRevokeBucketAccess, req, func() (proto.Message, error) {
+		if req.BucketId == "" {
+			return nil, status.Error(codes.InvalidArgument, "bucket id is required")
+		}
+		if req.AccountId == "" {
+			return nil, status.Error(codes.InvalidArgument, "account id is required")
+		}
+		p.s.store.deleteAccount(req.BucketId, req.AccountId)
+		return &cosi.ProvisionerRevokeBucketAccessResponse{}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*cosi.ProvisionerRevokeBucketAccessResponse), nil
+}
+
+func equalMaps(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key, value := range a {
+		if other, ok := b[key]; !ok || other != value {
+			return false
+		}
+	}
+	return true
+}
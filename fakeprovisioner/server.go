@@ -0,0 +1,318 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fakeprovisioner serves an in-memory implementation of the COSI
+// Identity and Provisioner services over a unix socket, for testing sidecars
+// and controllers without an object store. Calls are recorded for inspection,
+// and faults can be injected to exercise error handling.
+package fakeprovisioner
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	cosi "sigs.k8s.io/container-object-storage-interface-spec"
+)
+
+// Call is an RPC received by the Server
+type Call struct {
+	// Method is the name of the RPC, one of the conformance.RPC constants
+	Method   string
+	Request  proto.Message
+	Response proto.Message
+	Err      error
+	Time     time.Time
+}
+
+// Code returns the gRPC code the call returned with
+func (c Call) Code() codes.Code {
+	return status.Code(c.Err)
+}
+
+// Fault changes the outcome of the calls it matches
+type Fault struct {
+	// Method is the RPC the fault applies to, one of the conformance.RPC
+	// constants. The fault applies to all RPCs if it is empty.
+	Method string
+
+	// Match restricts the fault to the requests for which it returns true
+	Match func(req proto.Message) bool
+
+	// Latency delays the call. The call fails with the error of its context
+	// if the context is done first.
+	Latency time.Duration
+
+	// Code is the gRPC code the call fails with. The call is served normally
+	// after Latency if it is codes.OK.
+	Code codes.Code
+
+	// Message is the message of the error returned with Code
+	Message string
+
+	// Partial applies the call to the store before failing with Code, as if
+	// the object store completed the request but the response was lost
+	Partial bool
+
+	// Times is the number of calls the fault applies to before it is removed.
+	// The fault applies until the faults are cleared if it is 0.
+	Times int
+}
+
+func (f *Fault) matches(method string, req proto.Message) bool {
+	if f.Method != "" && f.Method != method {
+		return false
+	}
+	return f.Match == nil || f.Match(req)
+}
+
+// Server is an in-memory COSI provisioner
+type Server struct {
+	name string
+
+	dir        string
+	grpcServer *grpc.Server
+
+	lock     sync.Mutex
+	store    *store
+	faults   []*Fault
+	calls    []Call
+	serveErr error
+	done     chan struct{}
+}
+
+// NewServer starts a provisioner that identifies itself as name, listening on
+// a unix socket in a new temporary directory. Close stops it.
+func NewServer(name string) (*Server, error) {
+	dir, err := os.MkdirTemp("", "cosi-fakeprovisioner-")
+	if err != nil {
+		return nil, err
+	}
+	listener, err := net.Listen("unix", filepath.Join(dir, "cosi.sock"))
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+
+	s := &Server{
+		name:       name,
+		dir:        dir,
+		grpcServer: grpc.NewServer(),
+		store:      newStore(),
+		done:       make(chan struct{}),
+	}
+	cosi.RegisterIdentityServer(s.grpcServer, &identityServer{s})
+	cosi.RegisterProvisionerServer(s.grpcServer, &provisionerServer{s})
+
+	go func() {
+		err := s.grpcServer.Serve(listener)
+		s.lock.Lock()
+		s.serveErr = err
+		s.lock.Unlock()
+		close(s.done)
+	}()
+	return s, nil
+}
+
+// Address returns the path of the unix socket the server listens on
+func (s *Server) Address() string {
+	return filepath.Join(s.dir, "cosi.sock")
+}
+
+// Endpoint returns the address of the server as a gRPC target, e.g. for the
+// --driver-address flag of the provisioner sidecar
+func (s *Server) Endpoint() string {
+	return "unix://" + s.Address()
+}
+
+// Dial returns a client connection to the server
+func (s *Server) Dial(ctx context.Context) (*grpc.ClientConn, error) {
+	return grpc.DialContext(ctx, s.Endpoint(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+}
+
+// Close stops the server and removes its socket. It returns the error that
+// serving failed with, if any.
+func (s *Server) Close() error {
+	s.grpcServer.Stop()
+	<-s.done
+	os.RemoveAll(s.dir)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.serveErr
+}
+
+// InjectFault adds a fault. Faults are matched in the order they were added,
+// and only the first matching fault applies to a call.
+func (s *Server) InjectFault(fault Fault) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.faults = append(s.faults, &fault)
+}
+
+// ClearFaults removes all faults
+func (s *Server) ClearFaults() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.faults = nil
+}
+
+// Calls returns the calls received so far, in order. Calls to all RPCs are
+// returned if no method is given.
+func (s *Server) Calls(methods ...string) []Call {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	calls := []Call{}
+	for _, call := range s.calls {
+		if len(methods) == 0 || containsString(methods, call.Method) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// ResetCalls forgets the calls received so far
+func (s *Server) ResetCalls() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.calls = nil
+}
+
+// Buckets returns the buckets in the store
+func (s *Server) Buckets() []Bucket {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.store.listBuckets()
+}
+
+// Accounts returns the accounts that were granted access and not revoked
+func (s *Server) Accounts() []Account {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.store.listAccounts()
+}
+
+// AddBucket adds a bucket to the store as if it was created outside of COSI,
+// for testing the import of existing buckets. The id of the bucket is returned.
+func (s *Server) AddBucket(name string, protocol *cosi.Protocol, parameters map[string]string) string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.store.addBucket(name, protocol, parameters).ID
+}
+
+// serve runs an RPC through the faults before passing it to handler, and
+// records the call. handler is run with the lock held, so that the store is
+// consistent across concurrent calls.
+func (s *Server) serve(ctx context.Context, method string, req proto.Message, handler func() (proto.Message, error)) (proto.Message, error) {
+	call := Call{Method: method, Request: req, Time: time.Now()}
+	defer func() {
+		s.lock.Lock()
+		s.calls = append(s.calls, call)
+		s.lock.Unlock()
+	}()
+
+	fault := s.takeFault(method, req)
+	if fault != nil && fault.Latency > 0 {
+		select {
+		case <-time.After(fault.Latency):
+		case <-ctx.Done():
+			call.Err = status.FromContextError(ctx.Err()).Err()
+			return nil, call.Err
+		}
+	}
+
+	if fault != nil && fault.Code != codes.OK && !fault.Partial {
+		call.Err = status.Error(fault.Code, faultMessage(fault, method))
+		return nil, call.Err
+	}
+
+	s.lock.Lock()
+	call.Response, call.Err = handler()
+	s.lock.Unlock()
+
+	if fault != nil && fault.Code != codes.OK && call.Err == nil {
+		call.Response = nil
+		call.Err = status.Error(fault.Code, faultMessage(fault, method))
+	}
+	return call.Response, call.Err
+}
+
+// takeFault returns the first fault matching the call, and removes it if it
+// has been applied the number of times it was injected for. Match functions
+// are called without the lock held, so that they may call back into s.
+func (s *Server) takeFault(method string, req proto.Message) *Fault {
+	s.lock.Lock()
+	faults := append([]*Fault(nil), s.faults...)
+	s.lock.Unlock()
+
+	for _, fault := range faults {
+		if !fault.matches(method, req) {
+			continue
+		}
+		if s.consumeFault(fault) {
+			return fault
+		}
+	}
+	return nil
+}
+
+// consumeFault counts a call against fault, and returns false if the fault was
+// removed in the meantime by a concurrent call or ClearFaults
+func (s *Server) consumeFault(fault *Fault) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for i, f := range s.faults {
+		if f != fault {
+			continue
+		}
+		if fault.Times > 0 {
+			fault.Times--
+			if fault.Times == 0 {
+				s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func faultMessage(fault *Fault, method string) string {
+	if fault.Message != "" {
+		return fault.Message
+	}
+	return fmt.Sprintf("injected fault in %s", method)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
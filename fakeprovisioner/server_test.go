@@ -0,0 +1,133 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakeprovisioner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"sigs.k8s.io/container-object-storage-interface-api/conformance"
+	cosi "sigs.k8s.io/container-object-storage-interface-spec"
+)
+
+func newTestServer(t *testing.T) (*Server, cosi.ProvisionerClient) {
+	t.Helper()
+	s, err := NewServer("fake.objectstorage.k8s.io")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.Close() })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	conn, err := s.Dial(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return s, cosi.NewProvisionerClient(conn)
+}
+
+func TestGrantBucketAccess(t *testing.T) {
+	s, client := newTestServer(t)
+	ctx := context.Background()
+	bucketID := s.AddBucket("bucket", &cosi.Protocol{Type: &cosi.Protocol_S3{S3: &cosi.S3{}}}, nil)
+
+	grant := func(accessPolicy string, parameters map[string]string) (*cosi.ProvisionerGrantBucketAccessResponse, error) {
+		return client.ProvisionerGrantBucketAccess(ctx, &cosi.ProvisionerGrantBucketAccessRequest{
+			BucketId:     bucketID,
+			AccountName:  "account",
+			AccessPolicy: accessPolicy,
+			Parameters:   parameters,
+		})
+	}
+
+	first, err := grant("read", map[string]string{"key": "value"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := grant("read", map[string]string{"key": "value"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.AccountId != first.AccountId || second.Credentials != first.Credentials {
+		t.Errorf("second grant returned account %s, want %s with the same credentials", second.AccountId, first.AccountId)
+	}
+
+	tests := []struct {
+		name         string
+		accessPolicy string
+		parameters   map[string]string
+	}{
+		{"different access policy", "write", map[string]string{"key": "value"}},
+		{"different parameters", "read", map[string]string{"key": "other"}},
+		{"no parameters", "read", nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := grant(test.accessPolicy, test.parameters)
+			if code := status.Code(err); code != codes.AlreadyExists {
+				t.Errorf("got code %v, want %v: %v", code, codes.AlreadyExists, err)
+			}
+		})
+	}
+	if accounts := s.Accounts(); len(accounts) != 1 {
+		t.Errorf("got %d accounts, want 1", len(accounts))
+	}
+}
+
+func TestFaultMatchCallsServer(t *testing.T) {
+	s, client := newTestServer(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// fail the creation of a bucket that already exists, looking it up from
+	// the match function
+	s.InjectFault(Fault{
+		Method: conformance.RPCCreateBucket,
+		Match: func(req proto.Message) bool {
+			name := req.(*cosi.ProvisionerCreateBucketRequest).Name
+			for _, bucket := range s.Buckets() {
+				if bucket.Name == name {
+					return true
+				}
+			}
+			return false
+		},
+		Code:  codes.Unavailable,
+		Times: 1,
+	})
+
+	req := &cosi.ProvisionerCreateBucketRequest{
+		Name:     "bucket",
+		Protocol: &cosi.Protocol{Type: &cosi.Protocol_S3{S3: &cosi.S3{}}},
+	}
+	for i, want := range []codes.Code{codes.OK, codes.Unavailable, codes.OK} {
+		_, err := client.ProvisionerCreateBucket(ctx, req)
+		if code := status.Code(err); code != want {
+			t.Errorf("call %d: got code %v, want %v: %v", i, code, want, err)
+		}
+	}
+	if calls := s.Calls(conformance.RPCCreateBucket); len(calls) != 3 {
+		t.Errorf("got %d calls, want 3", len(calls))
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakeprovisioner
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"google.golang.org/protobuf/proto"
+
+	cosiapi "sigs.k8s.io/container-object-storage-interface-api/apis"
+	cosi "sigs.k8s.io/container-object-storage-interface-spec"
+)
+
+// Bucket is a bucket in the store of a Server
+type Bucket struct {
+	ID         string
+	Name       string
+	Protocol   *cosi.Protocol
+	Parameters map[string]string
+}
+
+// Account is an account that was granted access to a bucket
+type Account struct {
+	ID           string
+	Name         string
+	BucketID     string
+	AccessPolicy string
+	Parameters   map[string]string
+
+	// Credentials are returned to the caller as is. They are the JSON
+	// encoding of a SecretS3 with fake keys.
+	Credentials string
+}
+
+// store holds the state of a Server. It is not safe for concurrent use.
+type store struct {
+	next     int
+	buckets  map[string]*Bucket
+	accounts map[string]*Account
+}
+
+func newStore() *store {
+	return &store{
+		buckets:  map[string]*Bucket{},
+		accounts: map[string]*Account{},
+	}
+}
+
+// newID returns an id that differs from the names it is derived from, so that
+// callers that confuse names and ids are caught
+func (s *store) newID(prefix string) string {
+	s.next++
+	return fmt.Sprintf("%s-%d", prefix, s.next)
+}
+
+func (s *store) addBucket(name string, protocol *cosi.Protocol, parameters map[string]string) *Bucket {
+	bucket := &Bucket{
+		ID:         s.newID("bucket"),
+		Name:       name,
+		Protocol:   proto.Clone(protocol).(*cosi.Protocol),
+		Parameters: copyMap(parameters),
+	}
+	s.buckets[bucket.ID] = bucket
+	return bucket
+}
+
+func (s *store) bucketByName(name string) *Bucket {
+	for _, bucket := range s.buckets {
+		if bucket.Name == name {
+			return bucket
+		}
+	}
+	return nil
+}
+
+func (s *store) deleteBucket(id string) {
+	delete(s.buckets, id)
+	for accountID, account := range s.accounts {
+		if account.BucketID == id {
+			delete(s.accounts, accountID)
+		}
+	}
+}
+
+func (s *store) addAccount(bucketID, name, accessPolicy string, parameters map[string]string) *Account {
+	account := &Account{
+		ID:           s.newID("account"),
+		Name:         name,
+		BucketID:     bucketID,
+		AccessPolicy: accessPolicy,
+		Parameters:   copyMap(parameters),
+	}
+	creds, _ := json.Marshal(&cosiapi.SecretS3{
+		AccessKeyID:     account.ID,
+		AccessSecretKey: "secret-" + account.ID,
+	})
+	account.Credentials = string(creds)
+	s.accounts[account.ID] = account
+	return account
+}
+
+func (s *store) accountByName(bucketID, name string) *Account {
+	for _, account := range s.accounts {
+		if account.BucketID == bucketID && account.Name == name {
+			return account
+		}
+	}
+	return nil
+}
+
+func (s *store) deleteAccount(bucketID, id string) {
+	if account, ok := s.accounts[id]; ok && account.BucketID == bucketID {
+		delete(s.accounts, id)
+	}
+}
+
+// listBuckets returns copies of the buckets sorted by id
+func (s *store) listBuckets() []Bucket {
+	buckets := make([]Bucket, 0, len(s.buckets))
+	for _, bucket := range s.buckets {
+		b := *bucket
+		b.Protocol = proto.Clone(bucket.Protocol).(*cosi.Protocol)
+		b.Parameters = copyMap(bucket.Parameters)
+		buckets = append(buckets, b)
+	}
+	sort.Slice(buckets, func(i, j int) bool { return lessID(buckets[i].ID, buckets[j].ID) })
+	return buckets
+}
+
+// listAccounts returns copies of the accounts sorted by id
+func (s *store) listAccounts() []Account {
+	accounts := make([]Account, 0, len(s.accounts))
+	for _, account := range s.accounts {
+		a := *account
+		a.Parameters = copyMap(account.Parameters)
+		accounts = append(accounts, a)
+	}
+	sort.Slice(accounts, func(i, j int) bool { return lessID(accounts[i].ID, accounts[j].ID) })
+	return accounts
+}
+
+// lessID orders ids returned by newID by creation
+func lessID(a, b string) bool {
+	if len(a) != len(b) {
+		return len(a) < len(b)
+	}
+	return a < b
+}
+
+func copyMap(m map[string]string) map[string]string {
+	if m == nil {
+		return nil
+	}
+	c := make(map[string]string, len(m))
+	for key, value := range m {
+		c[key] = value
+	}
+	return c
+}
@@ -7,5 +7,21 @@ bash "${CODEGEN_PKG}"/generate-internal-groups.sh "deepcopy,client,informer,list
   sigs.k8s.io/container-object-storage-interface-api/client \
   sigs.k8s.io/container-object-storage-interface-api/apis \
   sigs.k8s.io/container-object-storage-interface-api/apis \
-  objectstorage:v1alpha1 \
+  objectstorage:v1alpha1,v1alpha2 \
+  --go-header-file "${SCRIPT_ROOT}/hack/boilerplate.go.txt"
+
+# BucketInfo is not part of an API group, so it is generated separately. The
+# openapi definitions of all packages are written to a single file, so they
+# are regenerated with the BucketInfo package included.
+"${GOPATH}/bin/deepcopy-gen" \
+  --input-dirs sigs.k8s.io/container-object-storage-interface-api/apis \
+  -O zz_generated.deepcopy \
+  --go-header-file "${SCRIPT_ROOT}/hack/boilerplate.go.txt"
+# the package is named after its directory by deepcopy-gen
+sed -i 's/^package apis$/package cosiapi/' "${SCRIPT_ROOT}/apis/zz_generated.deepcopy.go"
+"${GOPATH}/bin/openapi-gen" \
+  --input-dirs sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1,sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2,sigs.k8s.io/container-object-storage-interface-api/apis \
+  --input-dirs k8s.io/apimachinery/pkg/apis/meta/v1,k8s.io/apimachinery/pkg/runtime,k8s.io/apimachinery/pkg/version \
+  --output-package sigs.k8s.io/container-object-storage-interface-api/client/openapi \
+  -O zz_generated.openapi \
   --go-header-file "${SCRIPT_ROOT}/hack/boilerplate.go.txt"
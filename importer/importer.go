@@ -0,0 +1,213 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package importer imports buckets that were created outside of COSI by
+// creating a Bucket and a BucketClaim that are bound to each other
+package importer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1/validation"
+	bucketclientset "sigs.k8s.io/container-object-storage-interface-api/client/clientset/versioned"
+)
+
+// rollbackTimeout bounds the cleanup of a partial import. Cleanup does not use
+// the context of the import, as it must still run when that context is cancelled.
+const rollbackTimeout = 30 * time.Second
+
+// Options describe a bucket to import
+type Options struct {
+	// DriverName is the name of the driver that manages the bucket
+	DriverName string
+
+	// BucketID is the unique id of the bucket in the OSP
+	BucketID string
+
+	// Namespace is the namespace of the BucketClaim
+	Namespace string
+
+	// ClaimName is the name of the BucketClaim
+	ClaimName string
+
+	// BucketName is the name of the Bucket. Defaults to <namespace>-<claim name>.
+	BucketName string
+
+	// BucketClassName is the BucketClass recorded on the Bucket and BucketClaim.
+	// It is optional for imported buckets.
+	BucketClassName string
+
+	// Protocols are the data APIs supported by the bucket
+	Protocols []v1alpha1.Protocol
+
+	// DeletionPolicy of the Bucket. Defaults to Retain so that the bucket
+	// outlives the BucketClaim unless asked otherwise.
+	DeletionPolicy v1alpha1.DeletionPolicy
+
+	// Parameters are passed to the driver
+	Parameters map[string]string
+
+	// DryRun submits the objects to the API server without persisting them
+	DryRun bool
+}
+
+// NewObjects returns the Bucket and BucketClaim that import the bucket described
+// by opts, without creating them. The objects are validated, including that they
+// refer to each other.
+func NewObjects(opts Options) (*v1alpha1.Bucket, *v1alpha1.BucketClaim, error) {
+	if opts.BucketID == "" {
+		return nil, nil, fmt.Errorf("bucket id is required")
+	}
+	if opts.ClaimName == "" {
+		return nil, nil, fmt.Errorf("claim name is required")
+	}
+
+	bucketName := opts.BucketName
+	if bucketName == "" {
+		bucketName = fmt.Sprintf("%s-%s", opts.Namespace, opts.ClaimName)
+	}
+	deletionPolicy := opts.DeletionPolicy
+	if deletionPolicy == "" {
+		deletionPolicy = v1alpha1.DeletionPolicyRetain
+	}
+
+	bucket := &v1alpha1.Bucket{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: bucketName,
+		},
+		Spec: v1alpha1.BucketSpec{
+			DriverName:      opts.DriverName,
+			BucketClassName: opts.BucketClassName,
+			BucketClaim: &corev1.ObjectReference{
+				Kind:       "BucketClaim",
+				APIVersion: v1alpha1.SchemeGroupVersion.String(),
+				Namespace:  opts.Namespace,
+				Name:       opts.ClaimName,
+			},
+			Protocols:        opts.Protocols,
+			Parameters:       opts.Parameters,
+			DeletionPolicy:   deletionPolicy,
+			ExistingBucketID: opts.BucketID,
+		},
+	}
+	claim := &v1alpha1.BucketClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: opts.Namespace,
+			Name:      opts.ClaimName,
+		},
+		Spec: v1alpha1.BucketClaimSpec{
+			BucketClassName:    opts.BucketClassName,
+			Protocols:          opts.Protocols,
+			ExistingBucketName: bucketName,
+		},
+	}
+
+	allErrs := validation.ValidateBucket(bucket)
+	allErrs = append(allErrs, validation.ValidateBucketClaim(claim)...)
+	allErrs = append(allErrs, validation.ValidateBucketClaimReference(bucket, claim)...)
+	if len(allErrs) > 0 {
+		return nil, nil, allErrs.ToAggregate()
+	}
+	return bucket, claim, nil
+}
+
+// Import creates the Bucket and BucketClaim that import the bucket described by
+// opts. The Bucket is created first so that the BucketClaim is never left
+// pointing at a missing Bucket. If the BucketClaim cannot be created, the Bucket
+// is deleted again. Importing a bucket id that already has a Bucket for the same
+// driver fails.
+func Import(ctx context.Context, client bucketclientset.Interface, opts Options) (*v1alpha1.Bucket, *v1alpha1.BucketClaim, error) {
+	bucket, claim, err := NewObjects(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	existing, err := FindBucket(ctx, client, opts.DriverName, opts.BucketID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if existing != nil {
+		return nil, nil, fmt.Errorf("bucket %q of driver %s is already imported as Bucket %s", opts.BucketID, opts.DriverName, existing.Name)
+	}
+
+	createOpts := metav1.CreateOptions{}
+	if opts.DryRun {
+		createOpts.DryRun = []string{metav1.DryRunAll}
+	}
+
+	buckets := client.ObjectstorageV1alpha1().Buckets()
+	bucket, err = buckets.Create(ctx, bucket, createOpts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create Bucket: %w", err)
+	}
+
+	claim, err = client.ObjectstorageV1alpha1().BucketClaims(opts.Namespace).Create(ctx, claim, createOpts)
+	if err != nil {
+		err = fmt.Errorf("failed to create BucketClaim: %w", err)
+		if opts.DryRun {
+			return nil, nil, err
+		}
+		return nil, nil, utilerrors.NewAggregate([]error{err, rollback(client, bucket)})
+	}
+
+	return bucket, claim, nil
+}
+
+// FindBucket returns the Bucket of the driver with the given bucket id, or nil
+// if the bucket has not been provisioned or imported
+func FindBucket(ctx context.Context, client bucketclientset.Interface, driverName, bucketID string) (*v1alpha1.Bucket, error) {
+	list, err := client.ObjectstorageV1alpha1().Buckets().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Buckets: %w", err)
+	}
+	for i := range list.Items {
+		bucket := &list.Items[i]
+		if bucket.Spec.DriverName != driverName {
+			continue
+		}
+		if bucket.Spec.ExistingBucketID == bucketID || bucket.Status.BucketID == bucketID {
+			return bucket, nil
+		}
+	}
+	return nil, nil
+}
+
+// rollback deletes a Bucket created by a failed import. The Bucket is deleted
+// with a precondition on its uid so that a Bucket recreated by someone else
+// under the same name is left alone.
+func rollback(client bucketclientset.Interface, bucket *v1alpha1.Bucket) error {
+	ctx, cancel := context.WithTimeout(context.Background(), rollbackTimeout)
+	defer cancel()
+
+	deleteOpts := metav1.DeleteOptions{}
+	if bucket.UID != "" {
+		uid := bucket.UID
+		deleteOpts.Preconditions = &metav1.Preconditions{UID: &uid}
+	}
+	err := client.ObjectstorageV1alpha1().Buckets().Delete(ctx, bucket.Name, deleteOpts)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete Bucket %s after failed import: %w", bucket.Name, err)
+	}
+	return nil
+}
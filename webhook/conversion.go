@@ -0,0 +1,176 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2"
+)
+
+// ConvertPath is the path the conversion webhook is served on
+const ConvertPath = "/convert"
+
+// conversionScheme knows all served versions of the objectstorage kinds
+var conversionScheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(v1alpha1.AddToScheme(conversionScheme))
+	utilruntime.Must(v1alpha2.AddToScheme(conversionScheme))
+}
+
+// NewConversionHandler returns a handler that converts objectstorage objects
+// between the served versions. Objects are converted through the hub version,
+// v1alpha1.
+func NewConversionHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to read request: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		review := apiextensionsv1.ConversionReview{}
+		if err := json.Unmarshal(body, &review); err != nil {
+			http.Error(w, fmt.Sprintf("failed to decode conversion review: %v", err), http.StatusBadRequest)
+			return
+		}
+		if review.Request == nil {
+			http.Error(w, "conversion review has no request", http.StatusBadRequest)
+			return
+		}
+
+		review.Response = convertReview(review.Request)
+		review.Request = nil
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(&review); err != nil {
+			klog.ErrorS(err, "failed to write conversion response")
+		}
+	})
+}
+
+// convertReview converts all objects of req, failing the whole request if
+// any of them cannot be converted
+func convertReview(req *apiextensionsv1.ConversionRequest) *apiextensionsv1.ConversionResponse {
+	response := &apiextensionsv1.ConversionResponse{UID: req.UID}
+
+	for _, obj := range req.Objects {
+		converted, err := convert(obj.Raw, req.DesiredAPIVersion)
+		if err != nil {
+			response.ConvertedObjects = nil
+			response.Result = metav1.Status{
+				Status:  metav1.StatusFailure,
+				Message: err.Error(),
+			}
+			return response
+		}
+		response.ConvertedObjects = append(response.ConvertedObjects, runtime.RawExtension{Raw: converted})
+	}
+
+	response.Result = metav1.Status{Status: metav1.StatusSuccess}
+	return response
+}
+
+// convert converts the JSON encoded object raw to desiredAPIVersion
+func convert(raw []byte, desiredAPIVersion string) ([]byte, error) {
+	typeMeta := metav1.TypeMeta{}
+	if err := json.Unmarshal(raw, &typeMeta); err != nil {
+		return nil, fmt.Errorf("failed to decode object: %w", err)
+	}
+	if typeMeta.APIVersion == desiredAPIVersion {
+		return raw, nil
+	}
+
+	srcGVK := typeMeta.GroupVersionKind()
+	dstGV, err := schema.ParseGroupVersion(desiredAPIVersion)
+	if err != nil {
+		return nil, err
+	}
+	dstGVK := dstGV.WithKind(srcGVK.Kind)
+
+	src, err := conversionScheme.New(srcGVK)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(raw, src); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", srcGVK, err)
+	}
+	dst, err := conversionScheme.New(dstGVK)
+	if err != nil {
+		return nil, err
+	}
+
+	hub, err := toHub(src)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := dst.(conversion.Hub); ok {
+		dst = hub
+	} else if c, ok := dst.(conversion.Convertible); ok {
+		if err := c.ConvertFrom(hub); err != nil {
+			return nil, fmt.Errorf("failed to convert %s to %s: %w", srcGVK, dstGVK, err)
+		}
+	} else {
+		return nil, fmt.Errorf("%s is not convertible", dstGVK)
+	}
+
+	dst.GetObjectKind().SetGroupVersionKind(dstGVK)
+	return json.Marshal(dst)
+}
+
+// toHub returns obj converted to the hub version
+func toHub(obj runtime.Object) (conversion.Hub, error) {
+	if hub, ok := obj.(conversion.Hub); ok {
+		return hub, nil
+	}
+
+	c, ok := obj.(conversion.Convertible)
+	if !ok {
+		return nil, fmt.Errorf("%T is not convertible", obj)
+	}
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	hubObj, err := conversionScheme.New(v1alpha1.SchemeGroupVersion.WithKind(gvk.Kind))
+	if err != nil {
+		return nil, err
+	}
+	hub, ok := hubObj.(conversion.Hub)
+	if !ok {
+		return nil, fmt.Errorf("%T is not a conversion hub", hubObj)
+	}
+	if err := c.ConvertTo(hub); err != nil {
+		return nil, fmt.Errorf("failed to convert %s to the hub version: %w", gvk, err)
+	}
+	return hub, nil
+}
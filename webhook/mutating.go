@@ -0,0 +1,128 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	admissionv1 "k8s.io/api/admission/v1"
+
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1/defaults"
+	bucketlisters "sigs.k8s.io/container-object-storage-interface-api/client/listers/objectstorage/v1alpha1"
+)
+
+// MutatePath is the path the mutating webhook is served on
+const MutatePath = "/mutate"
+
+type jsonPatchOp struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+type mutator struct {
+	bucketClasses       bucketlisters.BucketClassLister
+	bucketAccessClasses bucketlisters.BucketAccessClassLister
+}
+
+// NewMutatingHandler returns a handler that sets the default BucketClass on
+// BucketClaims and the default BucketAccessClass on BucketAccesses that are
+// created without one. Classes are marked as default with the
+// v1alpha1.IsDefaultClassAnnotation annotation.
+func NewMutatingHandler(bucketClasses bucketlisters.BucketClassLister, bucketAccessClasses bucketlisters.BucketAccessClassLister) http.Handler {
+	m := &mutator{
+		bucketClasses:       bucketClasses,
+		bucketAccessClasses: bucketAccessClasses,
+	}
+	return serveAdmission(m.mutate)
+}
+
+func (m *mutator) mutate(req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	if req.Operation != admissionv1.Create {
+		return allowed()
+	}
+	if req.Kind.Group != v1alpha1.SchemeGroupVersion.Group || req.Kind.Version != v1alpha1.SchemeGroupVersion.Version {
+		return allowed()
+	}
+
+	var patch []jsonPatchOp
+	switch req.Kind.Kind {
+	case "BucketClaim":
+		claim := &v1alpha1.BucketClaim{}
+		if err := decode(req, claim); err != nil {
+			return errored(http.StatusBadRequest, err)
+		}
+		// claims for existing buckets do not need a class
+		if claim.Spec.BucketClassName != "" || claim.Spec.ExistingBucketName != "" {
+			return allowed()
+		}
+
+		class, err := defaults.DefaultBucketClass(m.bucketClasses)
+		if err != nil {
+			return errored(http.StatusInternalServerError, err)
+		}
+		if class == nil {
+			return allowed()
+		}
+		patch = append(patch, jsonPatchOp{Op: "add", Path: "/spec/bucketClassName", Value: class.Name})
+	case "BucketAccess":
+		access := &v1alpha1.BucketAccess{}
+		if err := decode(req, access); err != nil {
+			return errored(http.StatusBadRequest, err)
+		}
+		if access.Spec.BucketAccessClassName != "" {
+			return allowed()
+		}
+
+		class, err := defaults.DefaultBucketAccessClass(m.bucketAccessClasses)
+		if err != nil {
+			return errored(http.StatusInternalServerError, err)
+		}
+		if class == nil {
+			return allowed()
+		}
+		patch = append(patch, jsonPatchOp{Op: "add", Path: "/spec/bucketAccessClassName", Value: class.Name})
+	default:
+		return allowed()
+	}
+
+	return patched(patch)
+}
+
+func patched(patch []jsonPatchOp) *admissionv1.AdmissionResponse {
+	raw, err := json.Marshal(patch)
+	if err != nil {
+		return errored(http.StatusInternalServerError, err)
+	}
+	patchType := admissionv1.PatchTypeJSONPatch
+	return &admissionv1.AdmissionResponse{
+		Allowed:   true,
+		Patch:     raw,
+		PatchType: &patchType,
+	}
+}
+
+// decode decodes the object of req into obj
+func decode(req *admissionv1.AdmissionRequest, obj interface{}) error {
+	if err := json.Unmarshal(req.Object.Raw, obj); err != nil {
+		return fmt.Errorf("failed to decode %s: %w", req.Kind.Kind, err)
+	}
+	return nil
+}
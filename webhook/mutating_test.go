@@ -0,0 +1,150 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"net/http"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+	bucketlisters "sigs.k8s.io/container-object-storage-interface-api/client/listers/objectstorage/v1alpha1"
+)
+
+func TestMutate(t *testing.T) {
+	defaultAnnotations := map[string]string{v1alpha1.IsDefaultClassAnnotation: "true"}
+
+	testCases := []struct {
+		name          string
+		kind          string
+		op            admissionv1.Operation
+		obj           runtime.Object
+		classes       []*v1alpha1.BucketClass
+		accessClasses []*v1alpha1.BucketAccessClass
+		expectedPatch string
+		expectedCode  int32
+	}{
+		{
+			name:          "claim without class",
+			kind:          "BucketClaim",
+			op:            admissionv1.Create,
+			obj:           &v1alpha1.BucketClaim{},
+			classes:       []*v1alpha1.BucketClass{{ObjectMeta: metav1.ObjectMeta{Name: "default", Annotations: defaultAnnotations}}},
+			expectedPatch: `[{"op":"add","path":"/spec/bucketClassName","value":"default"}]`,
+		},
+		{
+			name:    "claim with class",
+			kind:    "BucketClaim",
+			op:      admissionv1.Create,
+			obj:     &v1alpha1.BucketClaim{Spec: v1alpha1.BucketClaimSpec{BucketClassName: "class"}},
+			classes: []*v1alpha1.BucketClass{{ObjectMeta: metav1.ObjectMeta{Name: "default", Annotations: defaultAnnotations}}},
+		},
+		{
+			name:    "claim for an existing bucket",
+			kind:    "BucketClaim",
+			op:      admissionv1.Create,
+			obj:     &v1alpha1.BucketClaim{Spec: v1alpha1.BucketClaimSpec{ExistingBucketName: "bucket"}},
+			classes: []*v1alpha1.BucketClass{{ObjectMeta: metav1.ObjectMeta{Name: "default", Annotations: defaultAnnotations}}},
+		},
+		{
+			name:    "claim without default class",
+			kind:    "BucketClaim",
+			op:      admissionv1.Create,
+			obj:     &v1alpha1.BucketClaim{},
+			classes: []*v1alpha1.BucketClass{{ObjectMeta: metav1.ObjectMeta{Name: "class"}}},
+		},
+		{
+			name: "claim with multiple default classes",
+			kind: "BucketClaim",
+			op:   admissionv1.Create,
+			obj:  &v1alpha1.BucketClaim{},
+			classes: []*v1alpha1.BucketClass{
+				{ObjectMeta: metav1.ObjectMeta{Name: "a", Annotations: defaultAnnotations}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "b", Annotations: defaultAnnotations}},
+			},
+			expectedCode: http.StatusInternalServerError,
+		},
+		{
+			name:    "claim update",
+			kind:    "BucketClaim",
+			op:      admissionv1.Update,
+			obj:     &v1alpha1.BucketClaim{},
+			classes: []*v1alpha1.BucketClass{{ObjectMeta: metav1.ObjectMeta{Name: "default", Annotations: defaultAnnotations}}},
+		},
+		{
+			name:          "access without class",
+			kind:          "BucketAccess",
+			op:            admissionv1.Create,
+			obj:           &v1alpha1.BucketAccess{},
+			accessClasses: []*v1alpha1.BucketAccessClass{{ObjectMeta: metav1.ObjectMeta{Name: "default", Annotations: defaultAnnotations}}},
+			expectedPatch: `[{"op":"add","path":"/spec/bucketAccessClassName","value":"default"}]`,
+		},
+		{
+			name:          "access with class",
+			kind:          "BucketAccess",
+			op:            admissionv1.Create,
+			obj:           &v1alpha1.BucketAccess{Spec: v1alpha1.BucketAccessSpec{BucketAccessClassName: "class"}},
+			accessClasses: []*v1alpha1.BucketAccessClass{{ObjectMeta: metav1.ObjectMeta{Name: "default", Annotations: defaultAnnotations}}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			classes := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			for _, class := range tc.classes {
+				classes.Add(class)
+			}
+			accessClasses := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			for _, class := range tc.accessClasses {
+				accessClasses.Add(class)
+			}
+			m := &mutator{
+				bucketClasses:       bucketlisters.NewBucketClassLister(classes),
+				bucketAccessClasses: bucketlisters.NewBucketAccessClassLister(accessClasses),
+			}
+
+			resp := m.mutate(&admissionv1.AdmissionRequest{
+				Kind:      metav1.GroupVersionKind{Group: v1alpha1.SchemeGroupVersion.Group, Version: v1alpha1.SchemeGroupVersion.Version, Kind: tc.kind},
+				Operation: tc.op,
+				Object:    runtime.RawExtension{Raw: mustMarshal(t, tc.obj)},
+			})
+
+			if tc.expectedCode != 0 {
+				if resp.Allowed || resp.Result == nil || resp.Result.Code != tc.expectedCode {
+					t.Fatalf("expected the request to fail with %d, got %+v", tc.expectedCode, resp)
+				}
+				return
+			}
+			if !resp.Allowed {
+				t.Fatalf("expected the request to be allowed, got %+v", resp.Result)
+			}
+			if string(resp.Patch) != tc.expectedPatch {
+				t.Errorf("expected patch %q, got %q", tc.expectedPatch, resp.Patch)
+			}
+			if tc.expectedPatch == "" && resp.PatchType != nil {
+				t.Errorf("expected no patch type, got %s", *resp.PatchType)
+			}
+			if tc.expectedPatch != "" && (resp.PatchType == nil || *resp.PatchType != admissionv1.PatchTypeJSONPatch) {
+				t.Errorf("expected a JSON patch, got %v", resp.PatchType)
+			}
+		})
+	}
+}
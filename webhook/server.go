@@ -0,0 +1,142 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhook implements admission webhooks for the objectstorage API
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	bucketlisters "sigs.k8s.io/container-object-storage-interface-api/client/listers/objectstorage/v1alpha1"
+)
+
+// Server serves the COSI admission webhooks over TLS
+type Server struct {
+	// Address is the address to listen on, e.g. ":9443"
+	Address string
+
+	// CertFile and KeyFile are the serving certificate and key
+	CertFile string
+	KeyFile  string
+
+	mux *http.ServeMux
+}
+
+// NewServer returns a Server with the validating webhook registered at ValidatePath,
+// the mutating webhook registered at MutatePath and the conversion webhook
+// registered at ConvertPath. The mutating webhook looks up the default classes
+// with bucketClasses and bucketAccessClasses.
+func NewServer(address, certFile, keyFile string, bucketClasses bucketlisters.BucketClassLister, bucketAccessClasses bucketlisters.BucketAccessClassLister) *Server {
+	s := &Server{
+		Address:  address,
+		CertFile: certFile,
+		KeyFile:  keyFile,
+		mux:      http.NewServeMux(),
+	}
+	s.Handle(ValidatePath, NewValidatingHandler())
+	s.Handle(MutatePath, NewMutatingHandler(bucketClasses, bucketAccessClasses))
+	s.Handle(ConvertPath, NewConversionHandler())
+	return s
+}
+
+// Handle registers an additional handler on the server
+func (s *Server) Handle(path string, handler http.Handler) {
+	s.mux.Handle(path, handler)
+}
+
+// Run serves the webhooks until ctx is done
+func (s *Server) Run(ctx context.Context) error {
+	server := &http.Server{Addr: s.Address, Handler: s.mux}
+	go func() {
+		<-ctx.Done()
+		if err := server.Shutdown(context.Background()); err != nil {
+			klog.ErrorS(err, "failed to shutdown webhook server")
+		}
+	}()
+
+	klog.V(2).InfoS("serving admission webhooks", "address", s.Address)
+	if err := server.ListenAndServeTLS(s.CertFile, s.KeyFile); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+// admitFunc handles a single admission request
+type admitFunc func(*admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse
+
+// serveAdmission decodes an AdmissionReview, passes the request to admit and
+// writes back its response
+func serveAdmission(admit admitFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to read request: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		review := admissionv1.AdmissionReview{}
+		if err := json.Unmarshal(body, &review); err != nil {
+			http.Error(w, fmt.Sprintf("failed to decode admission review: %v", err), http.StatusBadRequest)
+			return
+		}
+		if review.Request == nil {
+			http.Error(w, "admission review has no request", http.StatusBadRequest)
+			return
+		}
+
+		response := admit(review.Request)
+		response.UID = review.Request.UID
+		review.Response = response
+		review.Request = nil
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(&review); err != nil {
+			klog.ErrorS(err, "failed to write admission response")
+		}
+	})
+}
+
+func allowed() *admissionv1.AdmissionResponse {
+	return &admissionv1.AdmissionResponse{Allowed: true}
+}
+
+func denied(status metav1.Status) *admissionv1.AdmissionResponse {
+	return &admissionv1.AdmissionResponse{Allowed: false, Result: &status}
+}
+
+func errored(code int32, err error) *admissionv1.AdmissionResponse {
+	return &admissionv1.AdmissionResponse{
+		Allowed: false,
+		Result: &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    code,
+			Message: err.Error(),
+		},
+	}
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1/validation"
+)
+
+// ValidatePath is the path the validating webhook is served on
+const ValidatePath = "/validate"
+
+// NewValidatingHandler returns a handler that validates creates and updates of
+// all objectstorage kinds in every served version
+func NewValidatingHandler() http.Handler {
+	return serveAdmission(validate)
+}
+
+func validate(req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return allowed()
+	}
+	gvk := schema.GroupVersionKind(req.Kind)
+	if gvk.Group != v1alpha1.SchemeGroupVersion.Group || !conversionScheme.Recognizes(gvk) {
+		return allowed()
+	}
+
+	obj, err := decodeHub(gvk, req.Object.Raw)
+	if err != nil {
+		return errored(http.StatusBadRequest, err)
+	}
+	var old runtime.Object
+	if req.Operation == admissionv1.Update {
+		if old, err = decodeHub(gvk, req.OldObject.Raw); err != nil {
+			return errored(http.StatusBadRequest, fmt.Errorf("old object: %w", err))
+		}
+	}
+
+	if errs := validateHub(obj, old); len(errs) > 0 {
+		gk := schema.GroupKind{Group: req.Kind.Group, Kind: req.Kind.Kind}
+		return denied(apierrors.NewInvalid(gk, req.Name, errs).ErrStatus)
+	}
+	return allowed()
+}
+
+// validateHub validates the creation of obj, or its update from old if old is
+// not nil
+func validateHub(obj, old runtime.Object) field.ErrorList {
+	switch obj := obj.(type) {
+	case *v1alpha1.Bucket:
+		if old == nil {
+			return validation.ValidateBucket(obj)
+		}
+		return validation.ValidateBucketUpdate(old.(*v1alpha1.Bucket), obj)
+	case *v1alpha1.BucketClaim:
+		if old == nil {
+			return validation.ValidateBucketClaim(obj)
+		}
+		return validation.ValidateBucketClaimUpdate(old.(*v1alpha1.BucketClaim), obj)
+	case *v1alpha1.BucketAccess:
+		if old == nil {
+			return validation.ValidateBucketAccess(obj)
+		}
+		return validation.ValidateBucketAccessUpdate(old.(*v1alpha1.BucketAccess), obj)
+	case *v1alpha1.BucketClaimGrant:
+		if old == nil {
+			return validation.ValidateBucketClaimGrant(obj)
+		}
+		return validation.ValidateBucketClaimGrantUpdate(old.(*v1alpha1.BucketClaimGrant), obj)
+	case *v1alpha1.BucketClass:
+		if old == nil {
+			return validation.ValidateBucketClass(obj)
+		}
+		return validation.ValidateBucketClassUpdate(old.(*v1alpha1.BucketClass), obj)
+	case *v1alpha1.BucketAccessClass:
+		if old == nil {
+			return validation.ValidateBucketAccessClass(obj)
+		}
+		return validation.ValidateBucketAccessClassUpdate(old.(*v1alpha1.BucketAccessClass), obj)
+	}
+	return nil
+}
+
+// decodeHub decodes raw as gvk and converts it to the hub version, v1alpha1,
+// which the validation rules are written against. Objects of every served
+// version are therefore held to the same rules.
+func decodeHub(gvk schema.GroupVersionKind, raw []byte) (runtime.Object, error) {
+	obj, err := conversionScheme.New(gvk)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(raw, obj); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", gvk.Kind, err)
+	}
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+	return toHub(obj)
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha1"
+	"sigs.k8s.io/container-object-storage-interface-api/apis/objectstorage/v1alpha2"
+)
+
+func TestValidate(t *testing.T) {
+	claimV1alpha1 := func(class string, protocols ...v1alpha1.Protocol) *v1alpha1.BucketClaim {
+		return &v1alpha1.BucketClaim{
+			TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "BucketClaim"},
+			ObjectMeta: metav1.ObjectMeta{Name: "claim", Namespace: "ns"},
+			Spec:       v1alpha1.BucketClaimSpec{BucketClassName: class, Protocols: protocols},
+		}
+	}
+	claimV1alpha2 := func(class string, protocols ...v1alpha2.Protocol) *v1alpha2.BucketClaim {
+		return &v1alpha2.BucketClaim{
+			TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha2.SchemeGroupVersion.String(), Kind: "BucketClaim"},
+			ObjectMeta: metav1.ObjectMeta{Name: "claim", Namespace: "ns"},
+			Spec:       v1alpha2.BucketClaimSpec{BucketClassName: class, Protocols: protocols},
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		version string
+		op      admissionv1.Operation
+		obj     runtime.Object
+		old     runtime.Object
+		allowed bool
+	}{
+		{
+			name:    "valid v1alpha1 create",
+			version: "v1alpha1",
+			op:      admissionv1.Create,
+			obj:     claimV1alpha1("class", v1alpha1.ProtocolS3),
+			allowed: true,
+		},
+		{
+			name:    "invalid v1alpha1 create",
+			version: "v1alpha1",
+			op:      admissionv1.Create,
+			obj:     claimV1alpha1("class", "FTP"),
+		},
+		{
+			name:    "valid v1alpha2 create",
+			version: "v1alpha2",
+			op:      admissionv1.Create,
+			obj:     claimV1alpha2("class", v1alpha2.ProtocolS3),
+			allowed: true,
+		},
+		{
+			name:    "invalid v1alpha2 create",
+			version: "v1alpha2",
+			op:      admissionv1.Create,
+			obj:     claimV1alpha2("class", "FTP"),
+		},
+		{
+			name:    "v1alpha2 create without protocols",
+			version: "v1alpha2",
+			op:      admissionv1.Create,
+			obj:     claimV1alpha2("class"),
+		},
+		{
+			name:    "v1alpha2 update of an immutable field",
+			version: "v1alpha2",
+			op:      admissionv1.Update,
+			obj:     claimV1alpha2("other", v1alpha2.ProtocolS3),
+			old:     claimV1alpha2("class", v1alpha2.ProtocolS3),
+		},
+		{
+			name:    "v1alpha2 delete",
+			version: "v1alpha2",
+			op:      admissionv1.Delete,
+			obj:     claimV1alpha2("class", "FTP"),
+			allowed: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := &admissionv1.AdmissionRequest{
+				Kind:      metav1.GroupVersionKind{Group: v1alpha1.SchemeGroupVersion.Group, Version: tc.version, Kind: "BucketClaim"},
+				Name:      "claim",
+				Operation: tc.op,
+				Object:    runtime.RawExtension{Raw: mustMarshal(t, tc.obj)},
+			}
+			if tc.old != nil {
+				req.OldObject = runtime.RawExtension{Raw: mustMarshal(t, tc.old)}
+			}
+
+			resp := validate(req)
+			if resp.Allowed != tc.allowed {
+				t.Errorf("expected allowed %v, got %v: %+v", tc.allowed, resp.Allowed, resp.Result)
+			}
+			if !tc.allowed && resp.Result.Code != http.StatusUnprocessableEntity {
+				t.Errorf("expected the request to be denied as invalid, got %+v", resp.Result)
+			}
+		})
+	}
+}
+
+func mustMarshal(t *testing.T, obj interface{}) []byte {
+	t.Helper()
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return raw
+}